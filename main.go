@@ -5,10 +5,13 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 
 	"github.com/vmware/terraform-provider-vmc/vmc"
 )
@@ -18,16 +21,23 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return vmc.Provider()
-		},
+	// The plugin SDK provider is muxed with the plugin framework provider,
+	// which serves the ephemeral resources.
+	muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+		vmc.Provider().GRPCProvider,
+		providerserver.NewProtocol5(vmc.NewFrameworkProvider()),
+	)
+	if err != nil {
+		log.Fatal(err)
 	}
 
+	var serveOpts []tf5server.ServeOpt
 	if debugMode {
-		opts.Debug = true
-		opts.ProviderAddr = "vmware/vmc"
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	plugin.Serve(opts)
+	err = tf5server.Serve("registry.terraform.io/vmware/vmc", muxServer.ProviderServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
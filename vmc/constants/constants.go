@@ -69,6 +69,14 @@ const (
 	MinHosts = 2
 	MaxHosts = 16
 
+	// IP addresses the FQDNs of the management VMs resolve to
+	PublicIPType  = "public"
+	PrivateIPType = "private"
+
+	// Organization properties holding the organization limits
+	OrgHostLimitProperty = "hostLimit"
+	OrgSddcLimitProperty = "sddcLimit"
+
 	// Env variables used in acceptance tests
 	VmcURL         string = "VMC_URL"
 	CspURL         string = "CSP_URL"
@@ -6,8 +6,10 @@ package vmc
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/account_link"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/connector"
@@ -26,18 +28,86 @@ func dataSourceVmcConnectedAccounts() *schema.Resource {
 			},
 			"account_number": {
 				Type:        schema.TypeString,
-				Description: "AWS account number.",
-				Required:    true,
+				Description: "AWS account number. When set, only this connected account is returned and its identifier is the id of the data source.",
+				Optional:    true,
 			},
 			"id": {
 				Type:        schema.TypeString,
-				Description: "The corresponding connected (customer) account UUID this connection is attached to.",
+				Description: "The corresponding connected (customer) account UUID this connection is attached to, or the organization identifier when account_number is not set.",
+				Computed:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The identifiers of the connected accounts.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Description: "The connected accounts.",
 				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: connectedAccountAttributesSchema(),
+				},
 			},
 		},
 	}
 }
 
+// connectedAccountAttributesSchema the attributes of a connected account,
+// shared by the vmc_connected_accounts data source and the vmc_connected_account
+// resource.
+func connectedAccountAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connected_account_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the connected account.",
+		},
+		"account_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The AWS account number.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The state of the connected account: ACTIVE, BROKEN or DELETED.",
+		},
+		"cf_stack_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the CloudFormation stack that linked the account.",
+		},
+		"policy_payer_arn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ARN of the role of the VMC payer account.",
+		},
+		"policy_payer_linked_arn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ARN of the role assumed by the VMC payer account in the connected account.",
+		},
+		"policy_service_arn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ARN of the role assumed by VMC in the connected account.",
+		},
+		"policy_external_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The external identifier of the policies of the connected account.",
+		},
+		"regions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The regions whose availability zones are mapped for the connected account.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
 func dataSourceVmcConnectedAccountsRead(d *schema.ResourceData, m interface{}) error {
 	orgID := (m.(*connector.Wrapper)).OrgID
 	providerType := d.Get("provider_type").(string)
@@ -46,25 +116,60 @@ func dataSourceVmcConnectedAccountsRead(d *schema.ResourceData, m interface{}) e
 	connectorWrapper := (m.(*connector.Wrapper)).Connector
 	defaultConnectedAccountsClient := account_link.NewConnectedAccountsClient(connectorWrapper)
 	accounts, err := defaultConnectedAccountsClient.Get(orgID, &providerType)
-
-	if accountNumber == "" {
-		return fmt.Errorf("account number is a required parameter and cannot be empty")
+	if err != nil {
+		return HandleDataSourceReadError("Connected Accounts", err)
 	}
-	id := ""
-	for _, account := range accounts {
-		if *account.AccountNumber == accountNumber {
-			id = account.Id
+
+	if accountNumber != "" {
+		account := findConnectedAccount(accounts, accountNumber)
+		if account == nil {
+			return fmt.Errorf("no connected account found with the account number : %q ", accountNumber)
 		}
+		accounts = []model.AwsCustomerConnectedAccount{*account}
+		d.SetId(account.Id)
+	} else {
+		d.SetId(orgID)
 	}
 
-	if err != nil {
-		return HandleDataSourceReadError("Connected Accounts", err)
+	var ids []string
+	var flattenedAccounts []map[string]interface{}
+	for _, account := range accounts {
+		ids = append(ids, account.Id)
+		flattenedAccounts = append(flattenedAccounts, flattenConnectedAccount(account))
 	}
-
-	if id == "" {
-		return fmt.Errorf("no connected account found with the account number : %q ", accountNumber)
+	if err := d.Set("ids", ids); err != nil {
+		return err
 	}
+	return d.Set("accounts", flattenedAccounts)
+}
 
-	d.SetId(id)
+// findConnectedAccount the connected account of the AWS account number, deleted
+// accounts are ignored.
+func findConnectedAccount(accounts []model.AwsCustomerConnectedAccount, accountNumber string) *model.AwsCustomerConnectedAccount {
+	for i, account := range accounts {
+		if stringValue(account.AccountNumber) == accountNumber &&
+			stringValue(account.State) != model.AwsCustomerConnectedAccount_STATE_DELETED {
+			return &accounts[i]
+		}
+	}
 	return nil
 }
+
+func flattenConnectedAccount(account model.AwsCustomerConnectedAccount) map[string]interface{} {
+	regions := make([]string, 0, len(account.RegionToAzToShadowMapping))
+	for region := range account.RegionToAzToShadowMapping {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return map[string]interface{}{
+		"connected_account_id":    account.Id,
+		"account_number":          stringValue(account.AccountNumber),
+		"state":                   stringValue(account.State),
+		"cf_stack_name":           stringValue(account.CfStackName),
+		"policy_payer_arn":        stringValue(account.PolicyPayerArn),
+		"policy_payer_linked_arn": stringValue(account.PolicyPayerLinkedArn),
+		"policy_service_arn":      stringValue(account.PolicyServiceArn),
+		"policy_external_id":      stringValue(account.PolicyExternalId),
+		"regions":                 regions,
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/constants"
 )
@@ -23,6 +25,9 @@ func TestAccDataSourceVmcConnectedAccountsBasic(t *testing.T) {
 				Config: testAccDataSourceVmcConnectedAccountsConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.vmc_connected_accounts.my_accounts", "id"),
+					resource.TestCheckResourceAttr("data.vmc_connected_accounts.my_accounts", "accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.vmc_connected_accounts.my_accounts", "accounts.0.account_number", os.Getenv(constants.AwsAccountNumber)),
+					resource.TestCheckResourceAttrSet("data.vmc_connected_accounts.my_accounts", "accounts.0.state"),
 				),
 			},
 		},
@@ -37,3 +42,36 @@ data "vmc_connected_accounts" "my_accounts" {
 `, os.Getenv(constants.AwsAccountNumber),
 	)
 }
+
+func TestFindConnectedAccount(t *testing.T) {
+	newAccount := func(id string, accountNumber string, state string) model.AwsCustomerConnectedAccount {
+		return model.AwsCustomerConnectedAccount{Id: id, AccountNumber: &accountNumber, State: &state}
+	}
+	accounts := []model.AwsCustomerConnectedAccount{
+		newAccount("account-1", "123456789012", model.AwsCustomerConnectedAccount_STATE_DELETED),
+		newAccount("account-2", "123456789012", model.AwsCustomerConnectedAccount_STATE_ACTIVE),
+		newAccount("account-3", "210987654321", model.AwsCustomerConnectedAccount_STATE_BROKEN),
+	}
+
+	assert.Equal(t, "account-2", findConnectedAccount(accounts, "123456789012").Id)
+	assert.Equal(t, "account-3", findConnectedAccount(accounts, "210987654321").Id)
+	assert.Nil(t, findConnectedAccount(accounts, "000000000000"))
+}
+
+func TestFlattenConnectedAccount(t *testing.T) {
+	accountNumber := "123456789012"
+	account := model.AwsCustomerConnectedAccount{
+		Id:            "account-1",
+		AccountNumber: &accountNumber,
+		RegionToAzToShadowMapping: map[string]map[string]string{
+			"us-west-2": {"us-west-2a": "usw2-az1"},
+			"eu-west-1": {"eu-west-1a": "euw1-az1"},
+		},
+	}
+
+	flattenedAccount := flattenConnectedAccount(account)
+	assert.Equal(t, "account-1", flattenedAccount["connected_account_id"])
+	assert.Equal(t, accountNumber, flattenedAccount["account_number"])
+	assert.Equal(t, "", flattenedAccount["state"])
+	assert.Equal(t, []string{"eu-west-1", "us-west-2"}, flattenedAccount["regions"])
+}
@@ -7,10 +7,12 @@ package vmc
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/account_link"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/connector"
@@ -62,6 +64,17 @@ func dataSourceVmcCustomerSubnets() *schema.Resource {
 				Description: "The server instance type to be used.",
 				Optional:    true,
 			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Description: "Only return the subnets in this AWS availability zone, by name (e.g. us-west-2a) or identifier (e.g. usw2-az1).",
+				Optional:    true,
+			},
+			"compatible_only": {
+				Type:        schema.TypeBool,
+				Description: "Only return the subnets compatible with the SDDC.",
+				Optional:    true,
+				Default:     false,
+			},
 			"customer_available_zones": {
 				Type:        schema.TypeList,
 				Description: "A list of AWS availability zones.",
@@ -69,8 +82,10 @@ func dataSourceVmcCustomerSubnets() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"vpc_map": {
-				Type:     schema.TypeMap,
-				Computed: true,
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Never set.",
+				Deprecated:  "vpc_map is never set and will be removed in the next major release, use subnets instead",
 			},
 			"ids": {
 				Type:        schema.TypeList,
@@ -78,6 +93,66 @@ func dataSourceVmcCustomerSubnets() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Description: "The AWS subnets.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The AWS subnet ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the subnet.",
+						},
+						"cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR block of the subnet.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The AWS availability zone of the subnet.",
+						},
+						"availability_zone_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The AWS availability zone identifier of the subnet.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The VPC of the subnet.",
+						},
+						"vpc_cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR block of the VPC of the subnet.",
+						},
+						"compatible": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the subnet is compatible with the SDDC.",
+						},
+						"note": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the subnet is not compatible with the SDDC.",
+						},
+					},
+				},
+			},
+			"multi_az_subnet_ids": {
+				Type:        schema.TypeList,
+				Description: "One compatible subnet ID per availability zone, for MultiAZ SDDCs.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -114,24 +189,85 @@ func dataSourceVmcCustomerSubnetsRead(d *schema.ResourceData, m interface{}) err
 	connectorWrapper := (m.(*connector.Wrapper)).Connector
 	compatibleSubnetsClient := account_link.NewCompatibleSubnetsClient(connectorWrapper)
 	compatibleSubnets, err := compatibleSubnetsClient.Get(orgID, accountID, &region, &sddcID, &forceRefresh, instanceType, sddcType, &numHosts)
-	ids := []string{}
-	for _, value := range compatibleSubnets.VpcMap {
-		for _, subnet := range value.Subnets {
-			ids = append(ids, *subnet.SubnetId)
-		}
-	}
-	log.Printf("[DEBUG] Subnet IDs are %v\n", ids)
-
 	if err != nil {
 		return HandleDataSourceReadError("Customer Subnets", err)
 	}
 
+	subnets := flattenCompatibleSubnets(compatibleSubnets, d.Get("availability_zone").(string), d.Get("compatible_only").(bool))
+	ids := []string{}
+	for _, subnet := range subnets {
+		ids = append(ids, subnet["subnet_id"].(string))
+	}
+	log.Printf("[DEBUG] Subnet IDs are %v\n", ids)
+
 	if err := d.Set("ids", ids); err != nil {
 		return err
 	}
+	if err := d.Set("subnets", subnets); err != nil {
+		return err
+	}
+	if err := d.Set("multi_az_subnet_ids", oneSubnetPerAvailabilityZone(subnets)); err != nil {
+		return err
+	}
 	if err := d.Set("customer_available_zones", compatibleSubnets.CustomerAvailableZones); err != nil {
 		return err
 	}
 	d.SetId(fmt.Sprintf("%s-%s", orgID, accountID))
 	return nil
 }
+
+// flattenCompatibleSubnets lists the subnets of the VPCs, sorted by VPC and in
+// the order of the VMC API within a VPC, optionally only those of an
+// availability zone (name or identifier) or compatible with the SDDC.
+func flattenCompatibleSubnets(compatibleSubnets model.AwsCompatibleSubnets, availabilityZone string, compatibleOnly bool) []map[string]interface{} {
+	vpcKeys := make([]string, 0, len(compatibleSubnets.VpcMap))
+	for vpcKey := range compatibleSubnets.VpcMap {
+		vpcKeys = append(vpcKeys, vpcKey)
+	}
+	sort.Strings(vpcKeys)
+	subnets := []map[string]interface{}{}
+	for _, vpcKey := range vpcKeys {
+		for _, subnet := range compatibleSubnets.VpcMap[vpcKey].Subnets {
+			if availabilityZone != "" && stringValue(subnet.AvailabilityZone) != availabilityZone &&
+				stringValue(subnet.AvailabilityZoneId) != availabilityZone {
+				continue
+			}
+			if compatibleOnly && !boolValue(subnet.Compatible) {
+				continue
+			}
+			subnets = append(subnets, map[string]interface{}{
+				"subnet_id":            stringValue(subnet.SubnetId),
+				"name":                 stringValue(subnet.Name),
+				"cidr_block":           stringValue(subnet.SubnetCidrBlock),
+				"availability_zone":    stringValue(subnet.AvailabilityZone),
+				"availability_zone_id": stringValue(subnet.AvailabilityZoneId),
+				"vpc_id":               stringValue(subnet.VpcId),
+				"vpc_cidr_block":       stringValue(subnet.VpcCidrBlock),
+				"compatible":           boolValue(subnet.Compatible),
+				"note":                 stringValue(subnet.Note),
+			})
+		}
+	}
+	return subnets
+}
+
+// oneSubnetPerAvailabilityZone picks the first compatible subnet of each
+// availability zone, sorted by availability zone.
+func oneSubnetPerAvailabilityZone(subnets []map[string]interface{}) []string {
+	subnetIDs := map[string]string{}
+	var availabilityZones []string
+	for _, subnet := range subnets {
+		availabilityZone := subnet["availability_zone"].(string)
+		if _, ok := subnetIDs[availabilityZone]; ok || !subnet["compatible"].(bool) {
+			continue
+		}
+		subnetIDs[availabilityZone] = subnet["subnet_id"].(string)
+		availabilityZones = append(availabilityZones, availabilityZone)
+	}
+	sort.Strings(availabilityZones)
+	ids := []string{}
+	for _, availabilityZone := range availabilityZones {
+		ids = append(ids, subnetIDs[availabilityZone])
+	}
+	return ids
+}
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/constants"
 )
@@ -30,6 +32,10 @@ func TestAccDataSourceVmcCustomerSubnetsBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.vmc_customer_subnets.my_subnets", "ids.1", "subnet-01d62fb7a6ef9ca1b"),
 					resource.TestCheckResourceAttr("data.vmc_customer_subnets.my_subnets", "ids.2", "subnet-0cd7c7fdd15b08b07"),
 					resource.TestCheckResourceAttr("data.vmc_customer_subnets.my_subnets", "ids.3", "subnet-08d5d9dc3aad0383a"),
+					resource.TestCheckResourceAttr("data.vmc_customer_subnets.my_subnets", "subnets.#", "4"),
+					resource.TestCheckResourceAttr("data.vmc_customer_subnets.my_subnets", "subnets.0.subnet_id", "subnet-01715c65359792049"),
+					resource.TestCheckResourceAttrSet("data.vmc_customer_subnets.my_subnets", "subnets.0.availability_zone"),
+					resource.TestCheckResourceAttrSet("data.vmc_customer_subnets.my_subnets", "subnets.0.cidr_block"),
 				),
 			},
 		},
@@ -89,3 +95,40 @@ data "vmc_customer_subnets" "my_subnets" {
 }
 `, os.Getenv(constants.AwsAccountNumber))
 }
+
+func TestFlattenCompatibleSubnets(t *testing.T) {
+	newSubnet := func(subnetID string, availabilityZone string, compatible bool) model.SubnetInfo {
+		availabilityZoneID := "usw2-" + availabilityZone[len(availabilityZone)-1:]
+		return model.SubnetInfo{
+			SubnetId:           &subnetID,
+			AvailabilityZone:   &availabilityZone,
+			AvailabilityZoneId: &availabilityZoneID,
+			Compatible:         &compatible,
+		}
+	}
+	compatibleSubnets := model.AwsCompatibleSubnets{
+		VpcMap: map[string]model.VpcInfoSubnets{
+			"vpc-2": {Subnets: []model.SubnetInfo{newSubnet("subnet-4", "us-west-2b", true)}},
+			"vpc-1": {Subnets: []model.SubnetInfo{
+				newSubnet("subnet-3", "us-west-2b", false),
+				newSubnet("subnet-2", "us-west-2a", true),
+				newSubnet("subnet-1", "us-west-2a", true),
+			}},
+		},
+	}
+
+	subnetIDs := func(subnets []map[string]interface{}) []string {
+		var ids []string
+		for _, subnet := range subnets {
+			ids = append(ids, subnet["subnet_id"].(string))
+		}
+		return ids
+	}
+	subnets := flattenCompatibleSubnets(compatibleSubnets, "", false)
+	assert.Equal(t, []string{"subnet-3", "subnet-2", "subnet-1", "subnet-4"}, subnetIDs(subnets))
+	assert.Equal(t, []string{"subnet-2", "subnet-4"}, oneSubnetPerAvailabilityZone(subnets))
+	assert.Equal(t, []string{"subnet-2", "subnet-1", "subnet-4"}, subnetIDs(flattenCompatibleSubnets(compatibleSubnets, "", true)))
+	assert.Equal(t, []string{"subnet-3", "subnet-4"}, subnetIDs(flattenCompatibleSubnets(compatibleSubnets, "us-west-2b", false)))
+	assert.Equal(t, []string{"subnet-4"}, subnetIDs(flattenCompatibleSubnets(compatibleSubnets, "usw2-b", true)))
+	assert.Empty(t, oneSubnetPerAvailabilityZone(flattenCompatibleSubnets(model.AwsCompatibleSubnets{}, "", false)))
+}
@@ -5,8 +5,13 @@
 package vmc
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/connector"
 )
@@ -31,6 +36,83 @@ func dataSourceVmcOrg() *schema.Resource {
 				Description: "The Name of this resource",
 				Computed:    true,
 			},
+			"org_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the organization, for example CUSTOMER.",
+				Computed:    true,
+			},
+			"project_state": {
+				Type:        schema.TypeString,
+				Description: "The state of the organization, for example CREATED.",
+				Computed:    true,
+			},
+			"seller": {
+				Type:        schema.TypeString,
+				Description: "The seller of the organization, for example AWS.",
+				Computed:    true,
+			},
+			"seller_account_id": {
+				Type:        schema.TypeString,
+				Description: "The account identifier of the organization at the seller.",
+				Computed:    true,
+			},
+			"properties": {
+				Type:        schema.TypeMap,
+				Description: "The properties of the organization, such as its limits, SLA and feature flags.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled_features": {
+				Type:        schema.TypeList,
+				Description: "The names of the properties of the organization set to true.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"cloud_providers": {
+				Type:        schema.TypeList,
+				Description: "The cloud providers the organization can deploy SDDCs on.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cloud provider, for example AWS or ZEROCLOUD.",
+						},
+						"regions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The regions of the cloud provider the organization can deploy SDDCs in.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Description: "The creation date of the organization.",
+				Computed:    true,
+			},
+			"updated": {
+				Type:        schema.TypeString,
+				Description: "The last update date of the organization.",
+				Computed:    true,
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the user who created the organization.",
+				Computed:    true,
+			},
+			"updated_by_user_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the user who last updated the organization.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Description: "The version of the organization object.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -43,6 +125,10 @@ func dataSourceVmcOrgRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return HandleDataSourceReadError("VMC Organization", err)
 	}
+	cloudProviders, err := orgs.NewProvidersClient(connectorWrapper).List(orgID)
+	if err != nil {
+		return HandleDataSourceReadError("VMC Organization cloud providers", err)
+	}
 	d.SetId(orgID)
 	if err := d.Set("display_name", org.DisplayName); err != nil {
 		return err
@@ -50,6 +136,72 @@ func dataSourceVmcOrgRead(d *schema.ResourceData, m interface{}) error {
 	if err := d.Set("name", org.Name); err != nil {
 		return err
 	}
+	if err := d.Set("org_type", org.OrgType); err != nil {
+		return err
+	}
+	if err := d.Set("project_state", org.ProjectState); err != nil {
+		return err
+	}
+	if org.OrgSellerInfo != nil {
+		if err := d.Set("seller", org.OrgSellerInfo.Seller); err != nil {
+			return err
+		}
+		if err := d.Set("seller_account_id", org.OrgSellerInfo.SellerAccountId); err != nil {
+			return err
+		}
+	}
+	var properties map[string]string
+	if org.Properties != nil {
+		properties = org.Properties.Values
+	}
+	if err := d.Set("properties", properties); err != nil {
+		return err
+	}
+	if err := d.Set("enabled_features", enabledOrgFeatures(properties)); err != nil {
+		return err
+	}
+	if err := d.Set("cloud_providers", flattenCloudProviders(cloudProviders)); err != nil {
+		return err
+	}
+	if err := d.Set("created", org.Created.String()); err != nil {
+		return err
+	}
+	if err := d.Set("updated", org.Updated.String()); err != nil {
+		return err
+	}
+	if err := d.Set("user_name", org.UserName); err != nil {
+		return err
+	}
+	if err := d.Set("updated_by_user_name", org.UpdatedByUserName); err != nil {
+		return err
+	}
+	if err := d.Set("version", org.Version); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// enabledOrgFeatures the sorted names of the organization properties set to
+// true, e.g. enableZeroCloudCloudProvider.
+func enabledOrgFeatures(properties map[string]string) []string {
+	features := []string{}
+	for key, value := range properties {
+		if strings.EqualFold(value, "true") {
+			features = append(features, key)
+		}
+	}
+	sort.Strings(features)
+	return features
+}
+
+func flattenCloudProviders(cloudProviders []model.AwsCloudProvider) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, cloudProvider := range cloudProviders {
+		result = append(result, map[string]interface{}{
+			"provider_type": cloudProvider.Provider,
+			"regions":       cloudProvider.Regions,
+		})
+	}
+	return result
+}
@@ -0,0 +1,231 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func dataSourceVmcOrgQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcOrgQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"host_limit": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of ESX hosts of the organization, 0 if not reported.",
+				Computed:    true,
+			},
+			"sddc_limit": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of SDDCs of the organization, 0 if not reported.",
+				Computed:    true,
+			},
+			"limits": {
+				Type:        schema.TypeMap,
+				Description: "All the limits reported in the properties of the organization.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"hosts_used": {
+				Type:        schema.TypeInt,
+				Description: "Number of ESX hosts in the SDDCs of the organization.",
+				Computed:    true,
+			},
+			"sddcs_used": {
+				Type:        schema.TypeInt,
+				Description: "Number of SDDCs of the organization.",
+				Computed:    true,
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Description: "Number of ESX hosts and SDDCs per region and host instance type.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the ESX hosts.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type of the ESX hosts.",
+						},
+						"hosts": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of ESX hosts.",
+						},
+						"sddcs": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of SDDCs with ESX hosts of this instance type in the region.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVmcOrgQuotasRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	org, err := vmc.NewOrgsClient(connectorWrapper).Get(orgID)
+	if err != nil {
+		return HandleDataSourceReadError("VMC Organization", err)
+	}
+	sddcList, err := orgs.NewSddcsClient(connectorWrapper).List(orgID, nil, nil)
+	if err != nil {
+		return HandleListError("SDDCs", err)
+	}
+	limits := orgLimits(org)
+	usage, hostsUsed, sddcsUsed := orgHostUsage(sddcList)
+
+	d.SetId(orgID)
+	if err := d.Set("host_limit", limits[constants.OrgHostLimitProperty]); err != nil {
+		return err
+	}
+	if err := d.Set("sddc_limit", limits[constants.OrgSddcLimitProperty]); err != nil {
+		return err
+	}
+	if err := d.Set("limits", limits); err != nil {
+		return err
+	}
+	if err := d.Set("hosts_used", hostsUsed); err != nil {
+		return err
+	}
+	if err := d.Set("sddcs_used", sddcsUsed); err != nil {
+		return err
+	}
+	return d.Set("usage", usage)
+}
+
+// orgLimits the numeric organization properties whose name ends with Limit,
+// e.g. hostLimit.
+func orgLimits(org model.Organization) map[string]int {
+	limits := map[string]int{}
+	if org.Properties == nil {
+		return limits
+	}
+	for key, value := range org.Properties.Values {
+		if !strings.HasSuffix(key, "Limit") {
+			continue
+		}
+		if limit, err := strconv.Atoi(value); err == nil {
+			limits[key] = limit
+		}
+	}
+	return limits
+}
+
+// orgHostUsage counts the ESX hosts and the SDDCs that are not deleted, per
+// region and host instance type, sorted by region and instance type.
+func orgHostUsage(sddcList []model.Sddc) (usage []map[string]interface{}, hostsUsed int, sddcsUsed int) {
+	type usageKey struct{ region, instanceType string }
+	hosts := map[usageKey]int{}
+	sddcs := map[usageKey]int{}
+	for _, sddc := range sddcList {
+		if stringValue(sddc.SddcState) == "DELETED" {
+			continue
+		}
+		sddcsUsed++
+		if sddc.ResourceConfig == nil {
+			continue
+		}
+		sddcKeys := map[usageKey]bool{}
+		for _, cluster := range sddc.ResourceConfig.Clusters {
+			clusterInstanceType := ""
+			if cluster.EsxHostInfo != nil {
+				clusterInstanceType = stringValue(cluster.EsxHostInfo.InstanceType)
+			}
+			for _, host := range cluster.EsxHostList {
+				key := usageKey{region: stringValue(sddc.ResourceConfig.Region), instanceType: clusterInstanceType}
+				if host.InstanceType != nil {
+					key.instanceType = *host.InstanceType
+				}
+				hosts[key]++
+				sddcKeys[key] = true
+				hostsUsed++
+			}
+		}
+		for key := range sddcKeys {
+			sddcs[key]++
+		}
+	}
+	for key, count := range hosts {
+		usage = append(usage, map[string]interface{}{
+			"region":        key.region,
+			"instance_type": key.instanceType,
+			"hosts":         count,
+			"sddcs":         sddcs[key],
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i]["region"] != usage[j]["region"] {
+			return usage[i]["region"].(string) < usage[j]["region"].(string)
+		}
+		return usage[i]["instance_type"].(string) < usage[j]["instance_type"].(string)
+	})
+	return usage, hostsUsed, sddcsUsed
+}
+
+// hostQuotaCustomizeDiff fails the plan when the hosts added by increasing the
+// numHostsKey argument exceed the host limit of the organization. Only the
+// organization wide limit is known, limits per region or host instance type
+// are left to the VMC API.
+func hostQuotaCustomizeDiff(numHostsKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		connectorWrapper, ok := m.(*connector.Wrapper)
+		if !ok || !d.HasChange(numHostsKey) || !d.NewValueKnown(numHostsKey) {
+			return nil
+		}
+		oldNumHosts, newNumHosts := d.GetChange(numHostsKey)
+		additionalHosts := newNumHosts.(int) - oldNumHosts.(int)
+		if additionalHosts <= 0 {
+			return nil
+		}
+		orgID := connectorWrapper.OrgID
+		org, err := vmc.NewOrgsClient(connectorWrapper).Get(orgID)
+		if err != nil {
+			log.Printf("[WARN] Unable to check the host limit of the organization: %v", err)
+			return nil
+		}
+		hostLimit, ok := orgLimits(org)[constants.OrgHostLimitProperty]
+		if !ok {
+			return nil
+		}
+		sddcList, err := orgs.NewSddcsClient(connectorWrapper).List(orgID, nil, nil)
+		if err != nil {
+			log.Printf("[WARN] Unable to check the host usage of the organization: %v", err)
+			return nil
+		}
+		_, hostsUsed, _ := orgHostUsage(sddcList)
+		return checkHostQuota(hostLimit, hostsUsed, additionalHosts)
+	}
+}
+
+func checkHostQuota(hostLimit int, hostsUsed int, additionalHosts int) error {
+	if hostsUsed+additionalHosts > hostLimit {
+		return fmt.Errorf("adding %d hosts exceeds the host limit of the organization: %d of %d hosts are used",
+			additionalHosts, hostsUsed, hostLimit)
+	}
+	return nil
+}
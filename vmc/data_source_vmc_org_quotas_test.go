@@ -0,0 +1,87 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+)
+
+func TestAccDataSourceVmcOrgQuotasBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcOrgQuotasConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vmc_org_quotas.quotas", "hosts_used"),
+					resource.TestCheckResourceAttrSet("data.vmc_org_quotas.quotas", "usage.0.region"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcOrgQuotasConfig() string {
+	return `
+data "vmc_org_quotas" "quotas" {
+}
+`
+}
+
+func TestOrgLimits(t *testing.T) {
+	org := model.Organization{
+		Properties: &model.OrgProperties{
+			Values: map[string]string{
+				"hostLimit":       "20",
+				"sddcLimit":       "2",
+				"invalidLimit":    "unlimited",
+				"maxHostsPerSddc": "16",
+			},
+		},
+	}
+	assert.Equal(t, map[string]int{"hostLimit": 20, "sddcLimit": 2}, orgLimits(org))
+	assert.Empty(t, orgLimits(model.Organization{}))
+}
+
+func TestOrgHostUsage(t *testing.T) {
+	newSddc := func(state string, region string, clusters ...model.Cluster) model.Sddc {
+		return model.Sddc{
+			SddcState:      &state,
+			ResourceConfig: &model.AwsSddcResourceConfig{Region: &region, Clusters: clusters},
+		}
+	}
+	newCluster := func(instanceType string, numHosts int) model.Cluster {
+		return model.Cluster{
+			EsxHostInfo: &model.EsxHostInfo{InstanceType: &instanceType},
+			EsxHostList: make([]model.AwsEsxHost, numHosts),
+		}
+	}
+	sddcList := []model.Sddc{
+		newSddc("READY", "US_WEST_2", newCluster("i3en.metal", 3), newCluster("i4i.metal", 2)),
+		newSddc("READY", "US_WEST_2", newCluster("i3en.metal", 2)),
+		newSddc("DELETED", "US_WEST_2", newCluster("i3en.metal", 4)),
+		newSddc("READY", "EU_WEST_1", newCluster("i3en.metal", 2)),
+	}
+
+	usage, hostsUsed, sddcsUsed := orgHostUsage(sddcList)
+	assert.Equal(t, 9, hostsUsed)
+	assert.Equal(t, 3, sddcsUsed)
+	assert.Equal(t, []map[string]interface{}{
+		{"region": "EU_WEST_1", "instance_type": "i3en.metal", "hosts": 2, "sddcs": 1},
+		{"region": "US_WEST_2", "instance_type": "i3en.metal", "hosts": 5, "sddcs": 2},
+		{"region": "US_WEST_2", "instance_type": "i4i.metal", "hosts": 2, "sddcs": 1},
+	}, usage)
+}
+
+func TestCheckHostQuota(t *testing.T) {
+	assert.NoError(t, checkHostQuota(10, 8, 2))
+	assert.EqualError(t, checkHostQuota(10, 8, 3),
+		"adding 3 hosts exceeds the host limit of the organization: 8 of 10 hosts are used")
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/constants"
 )
@@ -22,6 +23,8 @@ func TestAccDataSourceVmcOrgBasic(t *testing.T) {
 				Config: testAccDataSourceVmcOrgConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.vmc_org.my_org", "display_name", os.Getenv(constants.OrgDisplayName)),
+					resource.TestCheckResourceAttrSet("data.vmc_org.my_org", "project_state"),
+					resource.TestCheckResourceAttrSet("data.vmc_org.my_org", "cloud_providers.0.provider_type"),
 				),
 			},
 		},
@@ -31,3 +34,14 @@ func TestAccDataSourceVmcOrgBasic(t *testing.T) {
 func testAccDataSourceVmcOrgConfig() string {
 	return `data "vmc_org" "my_org" {}`
 }
+
+func TestEnabledOrgFeatures(t *testing.T) {
+	properties := map[string]string{
+		"enableZeroCloudCloudProvider": "true",
+		"enableAWSCloudProvider":       "TRUE",
+		"skipSubscriptionCheck":        "false",
+		"hostLimit":                    "20",
+	}
+	assert.Equal(t, []string{"enableAWSCloudProvider", "enableZeroCloudCloudProvider"}, enabledOrgFeatures(properties))
+	assert.Empty(t, enabledOrgFeatures(nil))
+}
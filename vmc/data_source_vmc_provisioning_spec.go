@@ -0,0 +1,234 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func dataSourceVmcProvisioningSpec() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcProvisioningSpecRead,
+
+		Schema: map[string]*schema.Schema{
+			"provider_type": provisioningSpecProviderTypeSchema(),
+			"sddc_type": {
+				Type:        schema.TypeString,
+				Description: "Only return the specifications of this SDDC type, for example DEFAULT or 1NODE.",
+				Optional:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only return the specifications of this AWS specific (e.g. us-west-2) or VMC specific (e.g. US_WEST_2) region.",
+				Optional:    true,
+			},
+			"specs": {
+				Type:        schema.TypeList,
+				Description: "The provisioning specifications, one per SDDC type and region.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sddc_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SDDC type of the specification.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VMC specific region of the specification.",
+						},
+						"region_display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the region.",
+						},
+						"sddc_sizes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Sizes of the SDDC appliances that can be deployed.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Host instance types available in the region.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Host instance type.",
+									},
+									"display_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Display name of the host instance type.",
+									},
+									"hosts": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Numbers of hosts that can be provisioned.",
+										Elem:        &schema.Schema{Type: schema.TypeInt},
+									},
+									"min_hosts": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Minimum number of hosts that can be provisioned.",
+									},
+									"max_hosts": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Maximum number of hosts that can be provisioned.",
+									},
+									"cpu_cores": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Valid numbers of CPU cores of the hosts.",
+										Elem:        &schema.Schema{Type: schema.TypeInt},
+									},
+									"hyper_threading_supported": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "True if hyper-threading is supported.",
+									},
+									"vsan_esa_supported": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "True if vSAN ESA is supported.",
+									},
+									"storage_capacity_gib": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Storage capacity of a host in GiB.",
+									},
+									"esa_storage_capacity_gib": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "vSAN ESA storage capacity of a host in GiB.",
+									},
+									"memory_capacity_gib": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Memory capacity of a host in GiB.",
+									},
+									"total_number_of_cores": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of CPU cores of a host.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// provisioningSpecProviderTypeSchema the cloud provider whose provisioning
+// specification is returned.
+func provisioningSpecProviderTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  constants.AwsProviderType,
+		ValidateFunc: validation.StringInSlice([]string{
+			constants.AwsProviderType, constants.ZeroCloudProviderType}, false),
+		Description: "The cloud provider of the SDDCs (AWS or ZEROCLOUD). Default : AWS.",
+	}
+}
+
+func dataSourceVmcProvisioningSpecRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	provisionSpec, err := sddcs.NewProvisionSpecClient(connectorWrapper).Get(orgID)
+	if err != nil {
+		return HandleDataSourceReadError("Provisioning Spec", err)
+	}
+	specs := flattenProvisionSpec(provisionSpec.Provider[d.Get("provider_type").(string)],
+		d.Get("sddc_type").(string), d.Get("region").(string))
+	d.SetId(orgID)
+	return d.Set("specs", specs)
+}
+
+// flattenProvisionSpec lists the specification of each SDDC type and region,
+// sorted by SDDC type and region, optionally filtered by SDDC type and region.
+func flattenProvisionSpec(sddcConfigSpec model.SddcConfigSpec, sddcType string, region string) []map[string]interface{} {
+	var specs []map[string]interface{}
+	for configSddcType, configSpec := range sddcConfigSpec.SddcTypeConfigSpec {
+		if sddcType != "" && !strings.EqualFold(configSddcType, sddcType) {
+			continue
+		}
+		for configRegion, instanceTypeConfigs := range configSpec.Availability {
+			if region != "" && normalizeRegion(configRegion) != normalizeRegion(region) {
+				continue
+			}
+			var instanceTypes []map[string]interface{}
+			for _, instanceTypeConfig := range instanceTypeConfigs {
+				instanceTypes = append(instanceTypes, flattenInstanceTypeConfig(instanceTypeConfig))
+			}
+			specs = append(specs, map[string]interface{}{
+				"sddc_type":           configSddcType,
+				"region":              configRegion,
+				"region_display_name": sddcConfigSpec.RegionDisplayNames[configRegion],
+				"sddc_sizes":          configSpec.SddcSizes,
+				"instance_types":      instanceTypes,
+			})
+		}
+	}
+	sort.Slice(specs, func(i, j int) bool {
+		if specs[i]["sddc_type"] != specs[j]["sddc_type"] {
+			return specs[i]["sddc_type"].(string) < specs[j]["sddc_type"].(string)
+		}
+		return specs[i]["region"].(string) < specs[j]["region"].(string)
+	})
+	return specs
+}
+
+func flattenInstanceTypeConfig(instanceTypeConfig model.InstanceTypeConfig) map[string]interface{} {
+	hosts := make([]int, len(instanceTypeConfig.Hosts))
+	minHosts, maxHosts := 0, 0
+	for i, host := range instanceTypeConfig.Hosts {
+		hosts[i] = int(host)
+		if minHosts == 0 || hosts[i] < minHosts {
+			minHosts = hosts[i]
+		}
+		if hosts[i] > maxHosts {
+			maxHosts = hosts[i]
+		}
+	}
+	cpuCores := make([]int, len(instanceTypeConfig.CpuCores))
+	for i, cpuCore := range instanceTypeConfig.CpuCores {
+		cpuCores[i] = int(cpuCore)
+	}
+	instanceType := map[string]interface{}{
+		"instance_type":             stringValue(instanceTypeConfig.InstanceType),
+		"display_name":              stringValue(instanceTypeConfig.DisplayName),
+		"hosts":                     hosts,
+		"min_hosts":                 minHosts,
+		"max_hosts":                 maxHosts,
+		"cpu_cores":                 cpuCores,
+		"hyper_threading_supported": boolValue(instanceTypeConfig.HyperThreadingSupported),
+		"vsan_esa_supported":        boolValue(instanceTypeConfig.VsanEsaSupported),
+	}
+	if entityCapacity := instanceTypeConfig.EntityCapacity; entityCapacity != nil {
+		instanceType["storage_capacity_gib"] = int64Value(entityCapacity.StorageCapacityGib)
+		instanceType["esa_storage_capacity_gib"] = int64Value(entityCapacity.EsaStorageCapacityGib)
+		instanceType["memory_capacity_gib"] = int64Value(entityCapacity.MemoryCapacityGib)
+		instanceType["total_number_of_cores"] = int64Value(entityCapacity.TotalNumberOfCores)
+	}
+	return instanceType
+}
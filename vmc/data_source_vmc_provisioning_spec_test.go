@@ -0,0 +1,90 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+)
+
+func TestAccDataSourceVmcProvisioningSpecBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcProvisioningSpecConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vmc_provisioning_spec.spec", "specs.0.region"),
+					resource.TestCheckResourceAttrSet("data.vmc_provisioning_spec.spec", "specs.0.instance_types.0.instance_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcProvisioningSpecConfig() string {
+	return `
+data "vmc_provisioning_spec" "spec" {
+  provider_type = "ZEROCLOUD"
+}
+`
+}
+
+// testSddcConfigSpec a provisioning specification with the I3EN_METAL instance
+// type in US_WEST_2, also available for MultiAZ SDDCs, and the I4I_METAL
+// instance type in EU_WEST_1.
+func testSddcConfigSpec() model.SddcConfigSpec {
+	newInstanceTypeConfig := func(instanceType string, hosts ...int64) model.InstanceTypeConfig {
+		storageCapacityGib := int64(45000)
+		return model.InstanceTypeConfig{
+			InstanceType:   &instanceType,
+			Hosts:          hosts,
+			EntityCapacity: &model.InstanceEntityCapacity{StorageCapacityGib: &storageCapacityGib},
+		}
+	}
+	return model.SddcConfigSpec{
+		SddcTypeConfigSpec: map[string]model.ConfigSpec{
+			"DEFAULT": {
+				SddcSizes: []string{"medium", "large"},
+				Availability: map[string][]model.InstanceTypeConfig{
+					"US_WEST_2": {newInstanceTypeConfig("i3en.metal", 2, 3, 16)},
+					"EU_WEST_1": {newInstanceTypeConfig("i4i.metal", 3, 4)},
+				},
+			},
+			"MultiAZ": {
+				Availability: map[string][]model.InstanceTypeConfig{
+					"US_WEST_2": {newInstanceTypeConfig("i3en.metal", 2, 4, 6)},
+				},
+			},
+		},
+		RegionDisplayNames: map[string]string{"US_WEST_2": "US West (Oregon)", "EU_WEST_1": "Europe (Ireland)"},
+	}
+}
+
+func TestFlattenProvisionSpec(t *testing.T) {
+	sddcConfigSpec := testSddcConfigSpec()
+
+	specs := flattenProvisionSpec(sddcConfigSpec, "", "")
+	assert.Len(t, specs, 3)
+	assert.Equal(t, "DEFAULT", specs[0]["sddc_type"])
+	assert.Equal(t, "EU_WEST_1", specs[0]["region"])
+
+	specs = flattenProvisionSpec(sddcConfigSpec, "default", "us-west-2")
+	assert.Len(t, specs, 1)
+	assert.Equal(t, "US West (Oregon)", specs[0]["region_display_name"])
+	assert.Equal(t, []string{"medium", "large"}, specs[0]["sddc_sizes"])
+	instanceTypes := specs[0]["instance_types"].([]map[string]interface{})
+	assert.Equal(t, "i3en.metal", instanceTypes[0]["instance_type"])
+	assert.Equal(t, []int{2, 3, 16}, instanceTypes[0]["hosts"])
+	assert.Equal(t, 2, instanceTypes[0]["min_hosts"])
+	assert.Equal(t, 16, instanceTypes[0]["max_hosts"])
+	assert.Equal(t, int64(45000), instanceTypes[0]["storage_capacity_gib"])
+
+	assert.Empty(t, flattenProvisionSpec(model.SddcConfigSpec{}, "", ""))
+}
@@ -0,0 +1,128 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/infra"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func dataSourceVmcPublicIPs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcPublicIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:         schema.TypeString,
+				Description:  "SDDC identifier, used to look up the NSX API public endpoint url.",
+				Optional:     true,
+				ExactlyOneOf: []string{"sddc_id", "nsxt_reverse_proxy_url"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"nsxt_reverse_proxy_url": {
+				Type:        schema.TypeString,
+				Description: "NSX API public endpoint url of the SDDC.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Only return the public IPs with this display name.",
+				Optional:    true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Only return the public IPs whose display name matches this regular expression.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The identifiers of the public IPs.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"public_ips": {
+				Type:        schema.TypeList,
+				Description: "The public IPs.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Public IP identifier.",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Public IP.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the public IP.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVmcPublicIPsRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	nsxConnector, err := getNsxtConnector(d, connectorWrapper)
+	if err != nil {
+		return HandleCreateError("NSXT reverse proxy URL connector", err)
+	}
+	nsxtReverseProxyURL := d.Get("nsxt_reverse_proxy_url").(string)
+	if sddcID := d.Get("sddc_id").(string); sddcID != "" {
+		nsxtReverseProxyURL, err = getSddcNsxtReverseProxyURL(sddcID, connectorWrapper)
+		if err != nil {
+			return err
+		}
+	}
+	publicIPs, err := listPublicIPs(infra.NewPublicIpsClient(nsxConnector))
+	if err != nil {
+		return HandleListError("Public IP", err)
+	}
+
+	displayName := d.Get("display_name").(string)
+	var nameRegex *regexp.Regexp
+	if regex := d.Get("name_regex").(string); regex != "" {
+		nameRegex = regexp.MustCompile(regex)
+	}
+	ids := []string{}
+	var matchingPublicIPs []map[string]interface{}
+	for _, publicIP := range publicIPs {
+		name := stringValue(publicIP.DisplayName)
+		if displayName != "" && name != displayName {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		ids = append(ids, stringValue(publicIP.Id))
+		matchingPublicIPs = append(matchingPublicIPs, map[string]interface{}{
+			"id":           stringValue(publicIP.Id),
+			"ip":           stringValue(publicIP.Ip),
+			"display_name": name,
+		})
+	}
+	d.SetId(nsxtReverseProxyURL)
+	if err := d.Set("nsxt_reverse_proxy_url", nsxtReverseProxyURL); err != nil {
+		return err
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	return d.Set("public_ips", matchingPublicIPs)
+}
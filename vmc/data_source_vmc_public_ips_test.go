@@ -0,0 +1,49 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccDataSourceVmcPublicIPsBasic(t *testing.T) {
+	displayName := "terraform-test-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcPublicIPsConfig(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vmc_public_ips.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vmc_public_ips.test", "public_ips.0.ip",
+						"vmc_public_ip.public_ip_1", "ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcPublicIPsConfig(displayName string) string {
+	return fmt.Sprintf(`
+resource "vmc_public_ip" "public_ip_1" {
+  sddc_id      = %[1]q
+  display_name = %[2]q
+}
+
+data "vmc_public_ips" "test" {
+  sddc_id    = %[1]q
+  name_regex = "^${vmc_public_ip.public_ip_1.display_name}$"
+}
+`, os.Getenv(constants.TestSddcID), displayName,
+	)
+}
@@ -0,0 +1,100 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func dataSourceVmcRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"provider_type": provisioningSpecProviderTypeSchema(),
+			"sddc_type": {
+				Type:        schema.TypeString,
+				Description: "Only return the regions where this SDDC type can be deployed, for example DEFAULT or 1NODE.",
+				Optional:    true,
+			},
+			"host_instance_type": {
+				Type:        schema.TypeString,
+				Description: "Only return the regions where this host instance type is available, for example I3EN_METAL.",
+				Optional:    true,
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Description: "The VMC specific names of the regions, e.g. US_WEST_2.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"display_names": {
+				Type:        schema.TypeMap,
+				Description: "The display names of the regions.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceVmcRegionsRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	provisionSpec, err := sddcs.NewProvisionSpecClient(connectorWrapper).Get(orgID)
+	if err != nil {
+		return HandleDataSourceReadError("Regions", err)
+	}
+	sddcConfigSpec := provisionSpec.Provider[d.Get("provider_type").(string)]
+	regions := availableRegions(sddcConfigSpec, d.Get("sddc_type").(string), d.Get("host_instance_type").(string))
+	displayNames := make(map[string]string, len(regions))
+	for _, region := range regions {
+		displayNames[region] = sddcConfigSpec.RegionDisplayNames[region]
+	}
+	d.SetId(orgID)
+	if err := d.Set("regions", regions); err != nil {
+		return err
+	}
+	return d.Set("display_names", displayNames)
+}
+
+// availableRegions the sorted regions where SDDCs can be deployed, optionally
+// only those offering the SDDC type and host instance type.
+func availableRegions(sddcConfigSpec model.SddcConfigSpec, sddcType string, hostInstanceType string) []string {
+	regionSet := map[string]bool{}
+	for _, spec := range flattenProvisionSpec(sddcConfigSpec, sddcType, "") {
+		if hostInstanceType == "" || hasInstanceType(spec["instance_types"].([]map[string]interface{}), hostInstanceType) {
+			regionSet[spec["region"].(string)] = true
+		}
+	}
+	regions := make([]string, 0, len(regionSet))
+	for region := range regionSet {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// hasInstanceType accepts both the notation of the host_instance_type argument
+// (e.g. I3EN_METAL) and the one of the VMC API (e.g. i3en.metal).
+func hasInstanceType(instanceTypes []map[string]interface{}, hostInstanceType string) bool {
+	apiInstanceType, err := toHostInstanceType(strings.ToUpper(hostInstanceType))
+	if err != nil {
+		apiInstanceType = hostInstanceType
+	}
+	for _, instanceType := range instanceTypes {
+		if strings.EqualFold(instanceType["instance_type"].(string), apiInstanceType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceVmcRegionsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcRegionsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vmc_regions.regions", "regions.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcRegionsConfig() string {
+	return `
+data "vmc_regions" "regions" {
+  provider_type = "ZEROCLOUD"
+}
+`
+}
+
+func TestAvailableRegions(t *testing.T) {
+	sddcConfigSpec := testSddcConfigSpec()
+
+	assert.Equal(t, []string{"EU_WEST_1", "US_WEST_2"}, availableRegions(sddcConfigSpec, "", ""))
+	assert.Equal(t, []string{"US_WEST_2"}, availableRegions(sddcConfigSpec, "MultiAZ", ""))
+	assert.Equal(t, []string{"US_WEST_2"}, availableRegions(sddcConfigSpec, "", "I3EN_METAL"))
+	assert.Equal(t, []string{"EU_WEST_1"}, availableRegions(sddcConfigSpec, "", "i4i.metal"))
+	assert.Empty(t, availableRegions(sddcConfigSpec, "1NODE", ""))
+}
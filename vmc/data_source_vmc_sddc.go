@@ -7,9 +7,11 @@ package vmc
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs"
 
@@ -22,13 +24,17 @@ func dataSourceVmcSddc() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"sddc_id": {
-				Type:        schema.TypeString,
-				Description: "Sddc ID.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Sddc ID.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"sddc_id", "sddc_name"},
 			},
 			"sddc_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "Name of the SDDC, used to look up the SDDC when sddc_id is not set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"num_host": {
 				Type:     schema.TypeInt,
@@ -47,8 +53,10 @@ func dataSourceVmcSddc() *schema.Resource {
 				Computed: true,
 			},
 			"region": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "Region of the SDDC, used to disambiguate the SDDC looked up by sddc_name.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"sddc_state": {
 				Type:     schema.TypeString,
@@ -70,6 +78,12 @@ func dataSourceVmcSddc() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"omit_credentials_from_state": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not store the NSX passwords of the SDDC in the Terraform state.",
+			},
 			"nsxt_reverse_proxy_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,6 +93,12 @@ func dataSourceVmcSddc() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        clusterInfoSchema(),
+				Description: "Information about the clusters of the SDDC, such as name, state, ESX hosts, capacity and licensing.",
+			},
 			"nsxt_ui": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -88,16 +108,18 @@ func dataSourceVmcSddc() *schema.Resource {
 				Computed: true,
 			},
 			"nsxt_cloudadmin_password": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"nsxt_cloudaudit": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"nsxt_cloudaudit_password": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"nsxt_private_ip": {
 				Type:     schema.TypeString,
@@ -158,6 +180,16 @@ func dataSourceVmcSddcRead(d *schema.ResourceData, m interface{}) error {
 	sddcClient := orgs.NewSddcsClient(connectorWrapper)
 	sddcID := d.Get("sddc_id").(string)
 	orgID := (m.(*connector.Wrapper)).OrgID
+	if sddcID == "" {
+		sddcList, err := sddcClient.List(orgID, nil, nil)
+		if err != nil {
+			return HandleListError("SDDCs", err)
+		}
+		sddcID, err = findSddcIDByName(sddcList, d.Get("sddc_name").(string), d.Get("region").(string))
+		if err != nil {
+			return err
+		}
+	}
 	sddc, err := sddcClient.Get(orgID, sddcID)
 	if err != nil {
 		if err.Error() == errors.NewNotFound().Error() {
@@ -247,6 +279,13 @@ func dataSourceVmcSddcRead(d *schema.ResourceData, m interface{}) error {
 		if err := d.Set("availability_zones", sddc.ResourceConfig.AvailabilityZones); err != nil {
 			return err
 		}
+		var clusters []map[string]interface{}
+		for _, cluster := range sddc.ResourceConfig.Clusters {
+			clusters = append(clusters, flattenClusterInfo(cluster))
+		}
+		if err := d.Set("clusters", clusters); err != nil {
+			return err
+		}
 		if err := d.Set("deployment_type", ConvertDeployType(*sddc.ResourceConfig.DeploymentType)); err != nil {
 			return err
 		}
@@ -263,17 +302,6 @@ func dataSourceVmcSddcRead(d *schema.ResourceData, m interface{}) error {
 			if err := d.Set("nsxt_cloudadmin", *sddc.ResourceConfig.NsxCloudAdmin); err != nil {
 				return err
 			}
-			// Evade nil pointer dereference when user's access_token doesn't have NSX roles
-			if sddc.ResourceConfig.NsxCloudAdminPassword != nil {
-				if err := d.Set("nsxt_cloudadmin_password", *sddc.ResourceConfig.NsxCloudAdminPassword); err != nil {
-					return err
-				}
-			}
-			if sddc.ResourceConfig.NsxCloudAuditPassword != nil {
-				if err := d.Set("nsxt_cloudaudit_password", *sddc.ResourceConfig.NsxCloudAuditPassword); err != nil {
-					return err
-				}
-			}
 			if err := d.Set("nsxt_cloudaudit", *sddc.ResourceConfig.NsxCloudAudit); err != nil {
 				return err
 			}
@@ -286,5 +314,39 @@ func dataSourceVmcSddcRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	credentials := sddcCredentials(&sddc, d.Get("omit_credentials_from_state").(bool))
+	// The vCenter password is only available from the vmc_sddc_credentials
+	// ephemeral resource, so that it is never stored in the state
+	delete(credentials, "cloud_password")
+	for key, value := range credentials {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// findSddcIDByName looks up the SDDC with the given name, and region if not empty,
+// ignoring the deleted SDDCs. The SDDC must be unique.
+func findSddcIDByName(sddcList []model.Sddc, sddcName string, region string) (string, error) {
+	filter := sddcFilter{region: region}
+	var sddcIDs []string
+	for _, sddc := range sddcList {
+		if stringValue(sddc.Name) != sddcName || stringValue(sddc.SddcState) == "DELETED" || !filter.matches(sddc) {
+			continue
+		}
+		sddcIDs = append(sddcIDs, sddc.Id)
+	}
+	switch len(sddcIDs) {
+	case 0:
+		if region != "" {
+			return "", fmt.Errorf("no SDDC named %q found in region %s", sddcName, region)
+		}
+		return "", fmt.Errorf("no SDDC named %q found", sddcName)
+	case 1:
+		return sddcIDs[0], nil
+	default:
+		return "", fmt.Errorf("%d SDDCs named %q found (%s), set region or sddc_id to select one",
+			len(sddcIDs), sddcName, strings.Join(sddcIDs, ", "))
+	}
+}
@@ -0,0 +1,143 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func dataSourceVmcSddcHosts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcSddcHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:        schema.TypeString,
+				Description: "SDDC identifier.",
+				Required:    true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "Only return the ESX hosts of this cluster.",
+				Optional:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Only return the ESX hosts in this state, for example READY.",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The identifiers of the ESX hosts.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Description: "The ESX hosts of the SDDC.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"esx_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ESX host identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the ESX host.",
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Hostname of the ESX host.",
+						},
+						"cluster_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the cluster of the ESX host.",
+						},
+						"esx_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the ESX host.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Availability zone of the ESX host.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type of the ESX host.",
+						},
+						"host_cpu_cores_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of CPU cores enabled on the ESX host, 0 if all the cores are enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVmcSddcHostsRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	sddcID := d.Get("sddc_id").(string)
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		return HandleDataSourceReadError("SDDC Hosts", err)
+	}
+
+	hosts := flattenSddcHosts(sddc, d.Get("cluster_id").(string), d.Get("state").(string))
+	ids := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		ids = append(ids, host["esx_id"].(string))
+	}
+	d.SetId(sddcID)
+	if err := d.Set("hosts", hosts); err != nil {
+		return err
+	}
+	return d.Set("ids", ids)
+}
+
+// flattenSddcHosts returns the ESX hosts of all the clusters of the SDDC, filtered
+// by cluster and state when they are not empty.
+func flattenSddcHosts(sddc model.Sddc, clusterID string, state string) []map[string]interface{} {
+	hosts := []map[string]interface{}{}
+	if sddc.ResourceConfig == nil {
+		return hosts
+	}
+	for _, cluster := range sddc.ResourceConfig.Clusters {
+		if clusterID != "" && cluster.ClusterId != clusterID {
+			continue
+		}
+		for _, host := range cluster.EsxHostList {
+			if state != "" && !strings.EqualFold(stringValue(host.EsxState), state) {
+				continue
+			}
+			hosts = append(hosts, map[string]interface{}{
+				"esx_id":               stringValue(host.EsxId),
+				"name":                 stringValue(host.Name),
+				"hostname":             stringValue(host.Hostname),
+				"cluster_id":           cluster.ClusterId,
+				"esx_state":            stringValue(host.EsxState),
+				"availability_zone":    stringValue(host.AvailabilityZone),
+				"instance_type":        stringValue(host.InstanceType),
+				"host_cpu_cores_count": int(int64Value(cluster.HostCpuCoresCount)),
+			})
+		}
+	}
+	return hosts
+}
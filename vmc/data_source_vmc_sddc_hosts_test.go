@@ -0,0 +1,76 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccDataSourceVmcSddcHostsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcSddcHostsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					// TODO: consider adding another env variable for the primary cluster host count
+					resource.TestCheckResourceAttr("data.vmc_sddc_hosts.ready_hosts", "hosts.#", "2"),
+					resource.TestCheckResourceAttrSet("data.vmc_sddc_hosts.ready_hosts", "hosts.0.hostname"),
+					resource.TestCheckResourceAttrSet("data.vmc_sddc_hosts.ready_hosts", "hosts.0.availability_zone"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcSddcHostsConfig() string {
+	return fmt.Sprintf(`
+data "vmc_sddc" "sddc_1" {
+  sddc_id = %q
+}
+
+data "vmc_sddc_hosts" "ready_hosts" {
+  sddc_id    = data.vmc_sddc.sddc_1.id
+  cluster_id = data.vmc_sddc.sddc_1.clusters[0].cluster_id
+  state      = "READY"
+}
+`, os.Getenv(constants.TestSddcID),
+	)
+}
+
+func TestFlattenSddcHosts(t *testing.T) {
+	newHost := func(esxID string, esxState string) model.AwsEsxHost {
+		return model.AwsEsxHost{EsxId: &esxID, EsxState: &esxState}
+	}
+	cpuCores := int64(16)
+	sddc := model.Sddc{
+		ResourceConfig: &model.AwsSddcResourceConfig{
+			Clusters: []model.Cluster{
+				{ClusterId: "cluster-1", EsxHostList: []model.AwsEsxHost{newHost("esx-1", "READY"), newHost("esx-2", "FAILED")}},
+				{ClusterId: "cluster-2", HostCpuCoresCount: &cpuCores, EsxHostList: []model.AwsEsxHost{newHost("esx-3", "READY")}},
+			},
+		},
+	}
+
+	assert.Len(t, flattenSddcHosts(sddc, "", ""), 3)
+	assert.Len(t, flattenSddcHosts(sddc, "cluster-1", ""), 2)
+
+	readyHosts := flattenSddcHosts(sddc, "", "ready")
+	assert.Len(t, readyHosts, 2)
+	assert.Equal(t, "esx-3", readyHosts[1]["esx_id"])
+	assert.Equal(t, "cluster-2", readyHosts[1]["cluster_id"])
+	assert.Equal(t, 16, readyHosts[1]["host_cpu_cores_count"])
+
+	assert.Empty(t, flattenSddcHosts(model.Sddc{}, "", ""))
+}
@@ -0,0 +1,189 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func dataSourceVmcSddcTemplates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcSddcTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_sddc_id": {
+				Type:        schema.TypeString,
+				Description: "Only return the templates of this source SDDC.",
+				Optional:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Only return the templates in this state, for example AVAILABLE.",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The identifiers of the SDDC templates.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"templates": {
+				Type:        schema.TypeList,
+				Description: "The SDDC templates.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: sddcTemplateAttributesSchema(),
+				},
+			},
+		},
+	}
+}
+
+// sddcTemplateAttributesSchema the attributes of an SDDC template of the
+// vmc_sddc_templates data source.
+func sddcTemplateAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"template_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the SDDC template.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the SDDC template.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the SDDC template, for example AVAILABLE or INUSE.",
+		},
+		"source_sddc_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the source SDDC of the template.",
+		},
+		"created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Creation date of the SDDC template.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Region of the source SDDC.",
+		},
+		"provider_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cloud provider of the source SDDC.",
+		},
+		"deployment_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Deployment type of the source SDDC, SingleAZ or MultiAZ.",
+		},
+		"vpc_cidr": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Management network CIDR of the source SDDC.",
+		},
+		"account_link_sddc_config": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Connected accounts and subnets applied to the SDDCs created from the template.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connected_account_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"customer_subnet_ids": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"management_gateway_template_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of management gateway configurations in the network template.",
+		},
+		"compute_gateway_template_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of compute gateway configurations in the network template.",
+		},
+	}
+}
+
+func dataSourceVmcSddcTemplatesRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcTemplates, err := orgs.NewSddcTemplatesClient(connectorWrapper).List(orgID)
+	if err != nil {
+		return HandleListError("SDDC templates", err)
+	}
+
+	sourceSddcID := d.Get("source_sddc_id").(string)
+	state := d.Get("state").(string)
+	ids := []string{}
+	var templates []map[string]interface{}
+	for _, sddcTemplate := range sddcTemplates {
+		if sourceSddcID != "" && stringValue(sddcTemplate.SourceSddcId) != sourceSddcID {
+			continue
+		}
+		if state != "" && !strings.EqualFold(stringValue(sddcTemplate.State), state) {
+			continue
+		}
+		ids = append(ids, sddcTemplate.Id)
+		templates = append(templates, flattenSddcTemplate(sddcTemplate))
+	}
+	d.SetId(orgID)
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	return d.Set("templates", templates)
+}
+
+func flattenSddcTemplate(sddcTemplate model.SddcTemplate) map[string]interface{} {
+	var accountLinkSddcConfigs []map[string]interface{}
+	for _, accountLinkSddcConfig := range sddcTemplate.AccountLinkSddcConfigs {
+		accountLinkSddcConfigs = append(accountLinkSddcConfigs, map[string]interface{}{
+			"connected_account_id": stringValue(accountLinkSddcConfig.ConnectedAccountId),
+			"customer_subnet_ids":  accountLinkSddcConfig.CustomerSubnetIds,
+		})
+	}
+	attributes := map[string]interface{}{
+		"template_id":              sddcTemplate.Id,
+		"name":                     stringValue(sddcTemplate.Name),
+		"state":                    stringValue(sddcTemplate.State),
+		"source_sddc_id":           stringValue(sddcTemplate.SourceSddcId),
+		"created":                  sddcTemplate.Created.String(),
+		"account_link_sddc_config": accountLinkSddcConfigs,
+	}
+	if networkTemplate := sddcTemplate.NetworkTemplate; networkTemplate != nil {
+		attributes["management_gateway_template_count"] = len(networkTemplate.ManagementGatewayTemplates)
+		attributes["compute_gateway_template_count"] = len(networkTemplate.ComputeGatewayTemplates)
+	}
+	if sddcTemplate.Sddc != nil && sddcTemplate.Sddc.ResourceConfig != nil {
+		resourceConfig := sddcTemplate.Sddc.ResourceConfig
+		attributes["region"] = stringValue(resourceConfig.Region)
+		attributes["provider_type"] = resourceConfig.Provider
+		attributes["deployment_type"] = ConvertDeployType(stringValue(resourceConfig.DeploymentType))
+		if resourceConfig.VpcInfo != nil {
+			attributes["vpc_cidr"] = stringValue(resourceConfig.VpcInfo.VpcCidr)
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,81 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+)
+
+func TestAccDataSourceVmcSddcTemplatesBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcSddcTemplatesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vmc_sddc_templates.available", "ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcSddcTemplatesConfig() string {
+	return `
+data "vmc_sddc_templates" "available" {
+  state = "AVAILABLE"
+}
+`
+}
+
+func TestFlattenSddcTemplate(t *testing.T) {
+	name := "template-1"
+	state := model.SddcTemplate_STATE_AVAILABLE
+	sourceSddcID := "sddc-1"
+	region := "US_WEST_2"
+	deploymentType := "MULTI_AZ"
+	vpcCidr := "10.2.0.0/16"
+	connectedAccountID := "account-1"
+	sddcTemplate := model.SddcTemplate{
+		Id:           "template-id-1",
+		Name:         &name,
+		State:        &state,
+		SourceSddcId: &sourceSddcID,
+		AccountLinkSddcConfigs: []model.AccountLinkSddcConfig{
+			{ConnectedAccountId: &connectedAccountID, CustomerSubnetIds: []string{"subnet-1", "subnet-2"}},
+		},
+		NetworkTemplate: &model.NetworkTemplate{
+			ComputeGatewayTemplates: []model.ComputeGatewayTemplate{{}},
+		},
+		Sddc: &model.Sddc{
+			ResourceConfig: &model.AwsSddcResourceConfig{
+				Region:         &region,
+				Provider:       "AWS",
+				DeploymentType: &deploymentType,
+				VpcInfo:        &model.VpcInfo{VpcCidr: &vpcCidr},
+			},
+		},
+	}
+
+	attributes := flattenSddcTemplate(sddcTemplate)
+	assert.Equal(t, "template-id-1", attributes["template_id"])
+	assert.Equal(t, state, attributes["state"])
+	assert.Equal(t, sourceSddcID, attributes["source_sddc_id"])
+	assert.Equal(t, region, attributes["region"])
+	assert.Equal(t, "MultiAZ", attributes["deployment_type"])
+	assert.Equal(t, vpcCidr, attributes["vpc_cidr"])
+	assert.Equal(t, 0, attributes["management_gateway_template_count"])
+	assert.Equal(t, 1, attributes["compute_gateway_template_count"])
+	assert.Equal(t, []map[string]interface{}{
+		{"connected_account_id": connectedAccountID, "customer_subnet_ids": []string{"subnet-1", "subnet-2"}},
+	}, attributes["account_link_sddc_config"])
+
+	assert.NotContains(t, flattenSddcTemplate(model.SddcTemplate{Id: "template-id-2"}), "region")
+}
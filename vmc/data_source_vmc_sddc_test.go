@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/constants"
 )
@@ -25,6 +27,21 @@ func TestAccDataSourceVmcSddcBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.vmc_sddc.sddc_imported", "sddc_name", os.Getenv(constants.TestSddcName)),
 					// TODO: consider adding another env variable for the primary cluster host count
 					resource.TestCheckResourceAttr("data.vmc_sddc.sddc_imported", "num_host", "2"),
+					resource.TestCheckNoResourceAttr("data.vmc_sddc.sddc_imported", "cloud_password"),
+				),
+			},
+			{
+				Config: testAccDataSourceVmcSddcOmitCredentialsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vmc_sddc.sddc_imported", "nsxt_cloudadmin_password", ""),
+					resource.TestCheckResourceAttr("data.vmc_sddc.sddc_imported", "nsxt_cloudaudit_password", ""),
+				),
+			},
+			{
+				Config: testAccDataSourceVmcSddcByNameConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vmc_sddc.sddc_imported", "sddc_id", os.Getenv(constants.TestSddcID)),
+					resource.TestCheckResourceAttr("data.vmc_sddc.sddc_imported", "id", os.Getenv(constants.TestSddcID)),
 				),
 			},
 		},
@@ -39,3 +56,56 @@ data "vmc_sddc" "sddc_imported" {
 `, os.Getenv(constants.TestSddcID),
 	)
 }
+
+func testAccDataSourceVmcSddcOmitCredentialsConfig() string {
+	return fmt.Sprintf(`
+data "vmc_sddc" "sddc_imported" {
+  sddc_id                     = %q
+  omit_credentials_from_state = true
+}
+`, os.Getenv(constants.TestSddcID),
+	)
+}
+
+func testAccDataSourceVmcSddcByNameConfig() string {
+	return fmt.Sprintf(`
+data "vmc_sddc" "sddc_imported" {
+  sddc_name = %q
+}
+`, os.Getenv(constants.TestSddcName),
+	)
+}
+
+func TestFindSddcIDByName(t *testing.T) {
+	newSddc := func(id string, name string, state string, region string) model.Sddc {
+		return model.Sddc{
+			Id:             id,
+			Name:           &name,
+			SddcState:      &state,
+			ResourceConfig: &model.AwsSddcResourceConfig{Region: &region},
+		}
+	}
+	sddcList := []model.Sddc{
+		newSddc("sddc-1", "prod", "READY", "US_WEST_2"),
+		newSddc("sddc-2", "prod", "READY", "EU_WEST_1"),
+		newSddc("sddc-3", "dev", "DELETED", "US_WEST_2"),
+		newSddc("sddc-4", "dev", "READY", "US_WEST_2"),
+	}
+
+	sddcID, err := findSddcIDByName(sddcList, "dev", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "sddc-4", sddcID)
+
+	sddcID, err = findSddcIDByName(sddcList, "prod", "eu-west-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "sddc-2", sddcID)
+
+	_, err = findSddcIDByName(sddcList, "prod", "")
+	assert.ErrorContains(t, err, "2 SDDCs named \"prod\" found")
+
+	_, err = findSddcIDByName(sddcList, "test", "")
+	assert.ErrorContains(t, err, "no SDDC named \"test\" found")
+
+	_, err = findSddcIDByName(sddcList, "dev", "EU_WEST_1")
+	assert.ErrorContains(t, err, "in region EU_WEST_1")
+}
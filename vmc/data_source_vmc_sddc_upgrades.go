@@ -0,0 +1,177 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/tbrs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func dataSourceVmcSddcUpgrades() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcSddcUpgradesRead,
+
+		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:         schema.TypeString,
+				Description:  "Only return the upgrades of this SDDC.",
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"states": {
+				Type:        schema.TypeList,
+				Description: "Only return the upgrades in these states: SCHEDULED, RUNNING, CANCELED, COMPLETED or TERMINATED.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						model.ReservationWindow_RESERVATION_STATE_SCHEDULED, model.ReservationWindow_RESERVATION_STATE_RUNNING,
+						model.ReservationWindow_RESERVATION_STATE_CANCELED, model.ReservationWindow_RESERVATION_STATE_COMPLETED,
+						model.ReservationWindow_RESERVATION_STATE_TERMINATED}, false),
+				},
+			},
+			"upgrades": {
+				Type:        schema.TypeList,
+				Description: "The upgrade reservations, sorted by start date.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sddc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SDDC identifier.",
+						},
+						"reservation_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the reservation.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the reservation.",
+						},
+						"start_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start date of the reservation.",
+						},
+						"start_hour": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Start hour (UTC) of the reservation.",
+						},
+						"duration_hours": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Duration of the reservation in hours.",
+						},
+						"estimated_duration_hours_minimum": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum estimated duration of the upgrade in hours.",
+						},
+						"estimated_duration_hours_maximum": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum estimated duration of the upgrade in hours.",
+						},
+						"emergency": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True for an emergency upgrade.",
+						},
+						"manifest_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the manifest the SDDC is upgraded to.",
+						},
+						"maintenance_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the maintenance.",
+						},
+						"metadata": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Metadata of the reservation, such as its upgrade phase.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVmcSddcUpgradesRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcStateRequest := model.SddcStateRequest{}
+	if sddcID := d.Get("sddc_id").(string); sddcID != "" {
+		sddcStateRequest.Sddcs = []string{sddcID}
+	}
+	for _, state := range d.Get("states").([]interface{}) {
+		sddcStateRequest.States = append(sddcStateRequest.States, state.(string))
+	}
+
+	reservations, err := tbrs.NewReservationClient(connectorWrapper).Post(orgID, &sddcStateRequest)
+	if err != nil {
+		return HandleDataSourceReadError("SDDC Upgrades", err)
+	}
+	d.SetId(orgID)
+	return d.Set("upgrades", flattenReservationWindows(reservations))
+}
+
+// flattenReservationWindows lists the reservations of all the SDDCs, sorted by
+// start date and hour, SDDC and reservation.
+func flattenReservationWindows(reservations map[string][]model.ReservationWindow) []map[string]interface{} {
+	upgrades := []map[string]interface{}{}
+	for reservationsSddcID, reservationWindows := range reservations {
+		for _, reservationWindow := range reservationWindows {
+			maintenanceStatus := ""
+			if reservationWindow.MaintenanceProperties != nil {
+				maintenanceStatus = stringValue(reservationWindow.MaintenanceProperties.Status)
+			}
+			sddcID := reservationsSddcID
+			if reservationWindow.SddcId != nil {
+				sddcID = *reservationWindow.SddcId
+			}
+			upgrades = append(upgrades, map[string]interface{}{
+				"sddc_id":                          sddcID,
+				"reservation_id":                   stringValue(reservationWindow.ReserveId),
+				"state":                            stringValue(reservationWindow.ReservationState),
+				"start_date":                       stringValue(reservationWindow.StartDate),
+				"start_hour":                       int64Value(reservationWindow.StartHour),
+				"duration_hours":                   int64Value(reservationWindow.DurationHours),
+				"estimated_duration_hours_minimum": int64Value(reservationWindow.EstimatedDurationHoursMinimum),
+				"estimated_duration_hours_maximum": int64Value(reservationWindow.EstimatedDurationHoursMaximum),
+				"emergency":                        boolValue(reservationWindow.Emergency),
+				"manifest_id":                      stringValue(reservationWindow.ManifestId),
+				"maintenance_status":               maintenanceStatus,
+				"metadata":                         reservationWindow.Metadata,
+			})
+		}
+	}
+	sort.Slice(upgrades, func(i, j int) bool {
+		if upgrades[i]["start_date"] != upgrades[j]["start_date"] {
+			return upgrades[i]["start_date"].(string) < upgrades[j]["start_date"].(string)
+		}
+		if upgrades[i]["start_hour"] != upgrades[j]["start_hour"] {
+			return upgrades[i]["start_hour"].(int64) < upgrades[j]["start_hour"].(int64)
+		}
+		if upgrades[i]["sddc_id"] != upgrades[j]["sddc_id"] {
+			return upgrades[i]["sddc_id"].(string) < upgrades[j]["sddc_id"].(string)
+		}
+		return upgrades[i]["reservation_id"].(string) < upgrades[j]["reservation_id"].(string)
+	})
+	return upgrades
+}
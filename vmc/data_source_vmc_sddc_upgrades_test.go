@@ -0,0 +1,94 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccDataSourceVmcSddcUpgradesBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcSddcUpgradesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vmc_sddc_upgrades.scheduled", "upgrades.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcSddcUpgradesConfig() string {
+	return fmt.Sprintf(`
+data "vmc_sddc_upgrades" "scheduled" {
+  sddc_id = %q
+  states  = ["SCHEDULED", "RUNNING"]
+}
+`, os.Getenv(constants.TestSddcID),
+	)
+}
+
+func TestFlattenReservationWindows(t *testing.T) {
+	newReservationWindow := func(reserveID string, startDate string, startHour int64, status string) model.ReservationWindow {
+		state := model.ReservationWindow_RESERVATION_STATE_SCHEDULED
+		return model.ReservationWindow{
+			ReserveId:             &reserveID,
+			ReservationState:      &state,
+			StartDate:             &startDate,
+			StartHour:             &startHour,
+			MaintenanceProperties: &model.ReservationWindowMaintenanceProperties{Status: &status},
+			Metadata:              map[string]string{"phase": "PHASE_1"},
+		}
+	}
+	reservations := map[string][]model.ReservationWindow{
+		"sddc-1": {newReservationWindow("reservation-2", "2026-11-08", 2, "SCHEDULED")},
+		"sddc-2": {
+			newReservationWindow("reservation-3", "2026-11-08", 1, "SCHEDULED"),
+			newReservationWindow("reservation-1", "2026-11-01", 2, "NOTIFIED"),
+		},
+	}
+
+	upgrades := flattenReservationWindows(reservations)
+	assert.Len(t, upgrades, 3)
+	assert.Equal(t, "reservation-1", upgrades[0]["reservation_id"])
+	assert.Equal(t, "sddc-2", upgrades[0]["sddc_id"])
+	assert.Equal(t, "NOTIFIED", upgrades[0]["maintenance_status"])
+	assert.Equal(t, map[string]string{"phase": "PHASE_1"}, upgrades[0]["metadata"])
+	assert.Equal(t, "reservation-3", upgrades[1]["reservation_id"])
+	assert.Equal(t, "reservation-2", upgrades[2]["reservation_id"])
+	assert.Empty(t, flattenReservationWindows(nil))
+
+	// The SDDC of a reservation defaults to the key of its list, ties are sorted
+	// by reservation
+	otherSddcID := "sddc-3"
+	reservationWithSddcID := newReservationWindow("reservation-5", "2026-11-08", 1, "SCHEDULED")
+	reservationWithSddcID.SddcId = &otherSddcID
+	reservations = map[string][]model.ReservationWindow{
+		"sddc-2": {
+			reservationWithSddcID,
+			newReservationWindow("reservation-6", "2026-11-08", 1, "SCHEDULED"),
+			newReservationWindow("reservation-4", "2026-11-08", 1, "SCHEDULED"),
+		},
+	}
+	upgrades = flattenReservationWindows(reservations)
+	assert.Len(t, upgrades, 3)
+	assert.Equal(t, "reservation-4", upgrades[0]["reservation_id"])
+	assert.Equal(t, "sddc-2", upgrades[0]["sddc_id"])
+	assert.Equal(t, "reservation-6", upgrades[1]["reservation_id"])
+	assert.Equal(t, "sddc-2", upgrades[1]["sddc_id"])
+	assert.Equal(t, "reservation-5", upgrades[2]["reservation_id"])
+	assert.Equal(t, "sddc-3", upgrades[2]["sddc_id"])
+}
@@ -0,0 +1,250 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func dataSourceVmcSddcs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVmcSddcsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Only return the SDDCs whose name matches this regular expression.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only return the SDDCs in this AWS specific (e.g. us-west-2) or VMC specific (e.g. US_WEST_2) region.",
+				Optional:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Only return the SDDCs in this state, for example READY.",
+				Optional:    true,
+			},
+			"provider_type": {
+				Type:        schema.TypeString,
+				Description: "Only return the SDDCs of this cloud provider, AWS or ZEROCLOUD.",
+				Optional:    true,
+			},
+			"sddc_type": {
+				Type:        schema.TypeString,
+				Description: "Only return the SDDCs of this type, for example 1NODE.",
+				Optional:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "Only return the SDDCs whose VMC version starts with this version, for example 1.24.",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The identifiers of the SDDCs.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sddcs": {
+				Type:        schema.TypeList,
+				Description: "Summaries of the SDDCs.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sddc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SDDC identifier.",
+						},
+						"sddc_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the SDDC.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the SDDC.",
+						},
+						"sddc_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the SDDC.",
+						},
+						"provider_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider of the SDDC.",
+						},
+						"sddc_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the SDDC.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VMC version of the SDDC.",
+						},
+						"deployment_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment type of the SDDC, SingleAZ or MultiAZ.",
+						},
+						"num_host": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of ESX hosts in all the clusters of the SDDC.",
+						},
+						"vc_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "vCenter URL of the SDDC.",
+						},
+						"nsxt_reverse_proxy_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NSX reverse proxy URL of the SDDC.",
+						},
+						"created": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation date of the SDDC.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sddcFilter the criteria of the vmc_sddcs data source, empty criteria match all
+// the SDDCs.
+type sddcFilter struct {
+	nameRegex    *regexp.Regexp
+	region       string
+	state        string
+	providerType string
+	sddcType     string
+	version      string
+}
+
+func dataSourceVmcSddcsRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	filter := sddcFilter{
+		region:       d.Get("region").(string),
+		state:        d.Get("state").(string),
+		providerType: d.Get("provider_type").(string),
+		sddcType:     d.Get("sddc_type").(string),
+		version:      d.Get("version").(string),
+	}
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		filter.nameRegex = regexp.MustCompile(nameRegex)
+	}
+
+	sddcsClient := orgs.NewSddcsClient(connectorWrapper)
+	sddcs, err := sddcsClient.List(orgID, nil, nil)
+	if err != nil {
+		return HandleListError("SDDCs", err)
+	}
+
+	var ids []string
+	var summaries []map[string]interface{}
+	for _, sddc := range sddcs {
+		if !filter.matches(sddc) {
+			continue
+		}
+		ids = append(ids, sddc.Id)
+		summaries = append(summaries, flattenSddcSummary(sddc))
+	}
+	d.SetId(orgID)
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	return d.Set("sddcs", summaries)
+}
+
+func (filter sddcFilter) matches(sddc model.Sddc) bool {
+	if filter.nameRegex != nil && !filter.nameRegex.MatchString(stringValue(sddc.Name)) {
+		return false
+	}
+	if filter.state != "" && !strings.EqualFold(stringValue(sddc.SddcState), filter.state) {
+		return false
+	}
+	if filter.providerType != "" && !strings.EqualFold(stringValue(sddc.Provider), filter.providerType) {
+		return false
+	}
+	if filter.sddcType != "" && !strings.EqualFold(stringValue(sddc.SddcType), filter.sddcType) {
+		return false
+	}
+	if filter.region != "" && (sddc.ResourceConfig == nil ||
+		normalizeRegion(stringValue(sddc.ResourceConfig.Region)) != normalizeRegion(filter.region)) {
+		return false
+	}
+	if filter.version != "" && !matchesVersion(sddcVersion(sddc), filter.version) {
+		return false
+	}
+	return true
+}
+
+// matchesVersion reports whether the VMC version (e.g. 1.24v3) starts with the
+// whole segments of version, so 1.2 matches 1.2 and 1.2v1 but not 1.24.
+func matchesVersion(vmcVersion string, version string) bool {
+	if !strings.HasPrefix(vmcVersion, version) {
+		return false
+	}
+	rest := strings.TrimPrefix(vmcVersion, version)
+	return rest == "" || rest[0] == '.' || rest[0] == 'v'
+}
+
+// normalizeRegion converts an AWS specific region (e.g. us-west-2) to the VMC
+// specific one (e.g. US_WEST_2).
+func normalizeRegion(region string) string {
+	return strings.ReplaceAll(strings.ToUpper(region), "-", "_")
+}
+
+// sddcVersion the VMC version of the SDDC, e.g. 1.24, empty when not known yet.
+func sddcVersion(sddc model.Sddc) string {
+	if sddc.ResourceConfig == nil || sddc.ResourceConfig.SddcManifest == nil {
+		return ""
+	}
+	return stringValue(sddc.ResourceConfig.SddcManifest.VmcVersion)
+}
+
+func flattenSddcSummary(sddc model.Sddc) map[string]interface{} {
+	summary := map[string]interface{}{
+		"sddc_id":       sddc.Id,
+		"sddc_name":     stringValue(sddc.Name),
+		"sddc_state":    stringValue(sddc.SddcState),
+		"provider_type": stringValue(sddc.Provider),
+		"sddc_type":     stringValue(sddc.SddcType),
+		"version":       sddcVersion(sddc),
+		"created":       sddc.Created.String(),
+	}
+	if resourceConfig := sddc.ResourceConfig; resourceConfig != nil {
+		numHost := 0
+		for _, cluster := range resourceConfig.Clusters {
+			numHost += len(cluster.EsxHostList)
+		}
+		summary["region"] = stringValue(resourceConfig.Region)
+		summary["deployment_type"] = ConvertDeployType(stringValue(resourceConfig.DeploymentType))
+		summary["num_host"] = numHost
+		summary["vc_url"] = stringValue(resourceConfig.VcUrl)
+		summary["nsxt_reverse_proxy_url"] = stringValue(resourceConfig.NsxApiPublicEndpointUrl)
+	}
+	return summary
+}
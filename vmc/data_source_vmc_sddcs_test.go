@@ -0,0 +1,122 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccDataSourceVmcSddcsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVmcSddcsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vmc_sddcs.ready_sddcs", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.vmc_sddcs.ready_sddcs", "ids.0", os.Getenv(constants.TestSddcID)),
+					resource.TestCheckResourceAttr("data.vmc_sddcs.ready_sddcs", "sddcs.0.sddc_state", "READY"),
+					resource.TestCheckResourceAttrSet("data.vmc_sddcs.ready_sddcs", "sddcs.0.region"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVmcSddcsConfig() string {
+	return fmt.Sprintf(`
+data "vmc_sddcs" "ready_sddcs" {
+  name_regex = "^%s$"
+  state      = "READY"
+}
+`, regexp.QuoteMeta(os.Getenv(constants.TestSddcName)),
+	)
+}
+
+func TestSddcFilterMatches(t *testing.T) {
+	newSddc := func(name string, state string, region string, version string) model.Sddc {
+		return model.Sddc{
+			Name:      &name,
+			SddcState: &state,
+			ResourceConfig: &model.AwsSddcResourceConfig{
+				Region:       &region,
+				SddcManifest: &model.SddcManifest{VmcVersion: &version},
+			},
+		}
+	}
+	sddc := newSddc("prod-sddc-1", "READY", "US_WEST_2", "1.24v3")
+
+	assert.True(t, sddcFilter{}.matches(sddc))
+	assert.True(t, sddcFilter{nameRegex: regexp.MustCompile("^prod-")}.matches(sddc))
+	assert.False(t, sddcFilter{nameRegex: regexp.MustCompile("^dev-")}.matches(sddc))
+	assert.True(t, sddcFilter{state: "ready"}.matches(sddc))
+	assert.False(t, sddcFilter{state: "FAILED"}.matches(sddc))
+	assert.True(t, sddcFilter{region: "us-west-2"}.matches(sddc))
+	assert.False(t, sddcFilter{region: "eu-west-1"}.matches(sddc))
+	assert.True(t, sddcFilter{version: "1.24"}.matches(sddc))
+	assert.False(t, sddcFilter{version: "1.22"}.matches(sddc))
+	assert.False(t, sddcFilter{version: "1.2"}.matches(sddc))
+	assert.False(t, sddcFilter{providerType: "AWS"}.matches(sddc))
+	assert.False(t, sddcFilter{region: "us-west-2"}.matches(model.Sddc{}))
+}
+
+func TestMatchesVersion(t *testing.T) {
+	tests := []struct {
+		vmcVersion string
+		version    string
+		matches    bool
+	}{
+		{vmcVersion: "1.24v3", version: "1.24", matches: true},
+		{vmcVersion: "1.24v3", version: "1.24v3", matches: true},
+		{vmcVersion: "1.24v3", version: "1", matches: true},
+		{vmcVersion: "1.24.1", version: "1.24", matches: true},
+		{vmcVersion: "1.2", version: "1.2", matches: true},
+		{vmcVersion: "1.24v3", version: "1.2", matches: false},
+		{vmcVersion: "1.20", version: "1.2", matches: false},
+		{vmcVersion: "1.24v3", version: "1.24v", matches: false},
+		{vmcVersion: "1.22", version: "1.24", matches: false},
+		{vmcVersion: "", version: "1.24", matches: false},
+	}
+	for _, testCase := range tests {
+		assert.Equal(t, testCase.matches, matchesVersion(testCase.vmcVersion, testCase.version),
+			"version %s of %s", testCase.version, testCase.vmcVersion)
+	}
+}
+
+func TestFlattenSddcSummary(t *testing.T) {
+	name := "sddc-1"
+	region := "US_WEST_2"
+	deploymentType := "MULTI_AZ"
+	sddc := model.Sddc{
+		Id:   "sddc-id",
+		Name: &name,
+		ResourceConfig: &model.AwsSddcResourceConfig{
+			Region:         &region,
+			DeploymentType: &deploymentType,
+			Clusters: []model.Cluster{
+				{EsxHostList: make([]model.AwsEsxHost, 3)},
+				{EsxHostList: make([]model.AwsEsxHost, 2)},
+			},
+		},
+	}
+
+	summary := flattenSddcSummary(sddc)
+	assert.Equal(t, "sddc-id", summary["sddc_id"])
+	assert.Equal(t, "sddc-1", summary["sddc_name"])
+	assert.Equal(t, "US_WEST_2", summary["region"])
+	assert.Equal(t, "MultiAZ", summary["deployment_type"])
+	assert.Equal(t, 5, summary["num_host"])
+	assert.Equal(t, "", summary["version"])
+}
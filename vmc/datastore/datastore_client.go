@@ -0,0 +1,201 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package datastore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+const authnHeader = "csp-auth-token"
+
+// ErrDatastoreNotFound is returned when the requested datastore does not exist.
+var ErrDatastoreNotFound = fmt.Errorf("datastore not found")
+
+type Client interface {
+	connector.Authenticator
+	GetDatastore(datastoreID string) (NfsDatastore, error)
+	ListDatastores(sddcID string) ([]NfsDatastore, error)
+	AttachDatastore(sddcID string, config Config) (taskID string, responseErr error)
+	DetachDatastore(sddcID string, config Config) (taskID string, responseErr error)
+}
+
+// HTTPClient an interface, that is implemented by the http.DefaultClient,
+// intended to enable stubbing for testing purposes
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type ClientImpl struct {
+	connector  connector.Wrapper
+	httpClient HTTPClient
+}
+
+func NewDatastoreClient(wrapper connector.Wrapper) *ClientImpl {
+	copyWrapper := connector.CopyWrapper(wrapper)
+	return &ClientImpl{
+		connector:  *copyWrapper,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// newTestDatastoreClient intended for injecting dummy accessToken and stubbed httpClient for
+// testing purposes.
+func newTestDatastoreClient(vmcURL string, orgID string, accessToken string, httpClient HTTPClient) *ClientImpl {
+	testConnector := connector.Wrapper{
+		VmcURL: vmcURL,
+		OrgID:  orgID,
+	}
+	// Create a dummy connector to house the access token in a security context
+	testConnector.Connector = client.NewConnector("", client.WithHttpClient(&http.Client{}),
+		client.WithSecurityContext(security.NewOauthSecurityContext(accessToken)))
+	return &ClientImpl{
+		connector:  testConnector,
+		httpClient: httpClient,
+	}
+}
+
+// Authenticate grab an access token and set it into the Client instance for later use
+func (client *ClientImpl) Authenticate() error {
+	return client.connector.Authenticate()
+}
+
+func (client *ClientImpl) GetDatastore(datastoreID string) (NfsDatastore, error) {
+	var datastore NfsDatastore
+	getDatastoreURL := client.getBaseURL() + fmt.Sprintf("/inventory/%s/vmc-aws/datastores/%s",
+		client.connector.OrgID, datastoreID)
+	req, err := client.createNewRequest(http.MethodGet, getDatastoreURL, nil)
+	if err != nil {
+		return datastore, err
+	}
+	rawResponse, statusCode, err := client.executeRequest(req)
+	if err != nil {
+		return datastore, err
+	}
+	if statusCode == http.StatusNotFound {
+		return datastore, ErrDatastoreNotFound
+	}
+	if statusCode != http.StatusOK {
+		return datastore, fmt.Errorf("GetDatastore response code: %d \n body: %s", statusCode, string(*rawResponse))
+	}
+	err = json.NewDecoder(bytes.NewReader(*rawResponse)).Decode(&datastore)
+	return datastore, err
+}
+
+func (client *ClientImpl) ListDatastores(sddcID string) ([]NfsDatastore, error) {
+	var datastores []NfsDatastore
+	listDatastoresURL := client.getBaseURL() + fmt.Sprintf("/inventory/%s/vmc-aws/datastores?sddc_id=%s",
+		client.connector.OrgID, url.QueryEscape(sddcID))
+	req, err := client.createNewRequest(http.MethodGet, listDatastoresURL, nil)
+	if err != nil {
+		return datastores, err
+	}
+	rawResponse, statusCode, err := client.executeRequest(req)
+	if err != nil {
+		return datastores, err
+	}
+	if statusCode != http.StatusOK {
+		return datastores, fmt.Errorf("ListDatastores response code: %d \n body: %s", statusCode, string(*rawResponse))
+	}
+	err = json.NewDecoder(bytes.NewReader(*rawResponse)).Decode(&datastores)
+	return datastores, err
+}
+
+func (client *ClientImpl) AttachDatastore(sddcID string, config Config) (taskID string, responseErr error) {
+	operation := NewDatastoreOperation(client.connector.OrgID, sddcID, AttachDatastoreOperationType, config)
+	operationResponse, err := client.executeDatastoreOperation(operation)
+	if err != nil {
+		return "", err
+	}
+	return operationResponse.ID, nil
+}
+
+func (client *ClientImpl) DetachDatastore(sddcID string, config Config) (taskID string, responseErr error) {
+	operation := NewDatastoreOperation(client.connector.OrgID, sddcID, DetachDatastoreOperationType, config)
+	operationResponse, err := client.executeDatastoreOperation(operation)
+	if err != nil {
+		return "", err
+	}
+	return operationResponse.ID, nil
+}
+
+func (client *ClientImpl) executeDatastoreOperation(operation *DatastoreOperation) (*DatastoreOperation, error) {
+	requestPayload, err := json.Marshal(operation)
+	if err != nil {
+		return nil, err
+	}
+	operationsURL := client.getBaseURL() + fmt.Sprintf("/inventory/%s/vmc-aws/operations", client.connector.OrgID)
+	req, err := client.createNewRequest(http.MethodPost, operationsURL, bytes.NewBuffer(requestPayload))
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse, statusCode, err := client.executeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusOK || statusCode == http.StatusCreated {
+		var operationResponse DatastoreOperation
+		err := json.NewDecoder(bytes.NewReader(*rawResponse)).Decode(&operationResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &operationResponse, nil
+	}
+	return nil, fmt.Errorf("%s response code: %d \n body: %s", operation.Type, statusCode, string(*rawResponse))
+}
+
+// executeRequest Returns the body of the response as byte array pointer, the status code
+// or any error that may have occurred during the Http communication.
+func (client *ClientImpl) executeRequest(request *http.Request) (responseBody *[]byte, statusCode int, responseErr error) {
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing body of http response: %v", err)
+		}
+	}(response.Body)
+
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil, response.StatusCode, fmt.Errorf("unauthenticated request ")
+	}
+	if response.StatusCode == http.StatusForbidden {
+		return nil, response.StatusCode, fmt.Errorf("unauthorized request ")
+	}
+	result, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, response.StatusCode, err
+	}
+	return &result, response.StatusCode, nil
+}
+
+func (client *ClientImpl) getBaseURL() string {
+	return client.connector.VmcURL + "/api"
+}
+
+func (client *ClientImpl) createNewRequest(method string, URL string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add(authnHeader, client.connector.Connector.SecurityContext().Property(security.ACCESS_TOKEN).(string))
+	if method == http.MethodPost {
+		req.Header.Add("content-type", "application/json")
+	}
+	return req, nil
+}
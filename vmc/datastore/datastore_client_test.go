@@ -0,0 +1,154 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package datastore
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccessToken = "testAccessToken"
+const testOrgID = "testOrgID"
+const testVmcURL = "https://test.vmc.vmware.com"
+
+type HTTPClientStub struct {
+	expectedJSON   string
+	expectedMethod string
+	expectedURL    string
+	responseJSON   string
+	responseCode   int
+	responseError  error
+	t              *testing.T
+}
+
+func (stub *HTTPClientStub) Do(req *http.Request) (*http.Response, error) {
+	if req.Body == nil {
+		assert.Equal(stub.t, stub.expectedJSON, "")
+	} else {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(stub.t, err)
+		assert.Equal(stub.t, stub.expectedJSON, string(body))
+	}
+	assert.Equal(stub.t, stub.expectedURL, req.URL.String())
+	assert.Equal(stub.t, stub.expectedMethod, req.Method)
+	assert.Equal(stub.t, testAccessToken, req.Header.Get(authnHeader))
+	if stub.responseError != nil {
+		return nil, stub.responseError
+	}
+	return &http.Response{
+		StatusCode: stub.responseCode,
+		Body:       io.NopCloser(strings.NewReader(stub.responseJSON)),
+	}, nil
+}
+
+func TestGetDatastore(t *testing.T) {
+	datastoreURL := "https://test.vmc.vmware.com/api/inventory/testOrgID/vmc-aws/datastores/ds-1"
+	tests := []struct {
+		stub *HTTPClientStub
+		want NfsDatastore
+		err  error
+	}{
+		{
+			stub: &HTTPClientStub{
+				expectedMethod: http.MethodGet,
+				expectedURL:    datastoreURL,
+				responseCode:   http.StatusOK,
+				responseJSON: `{"id":"ds-1","name":"fsx-1","sddc_id":"sddc-1","nfs_server":"10.0.0.10",` +
+					`"nfs_mount_folder":"/vol1","cluster_ids":["cluster-1"],"state":"ATTACHED"}`,
+				t: t,
+			},
+			want: NfsDatastore{
+				ID:         "ds-1",
+				Name:       "fsx-1",
+				SddcID:     "sddc-1",
+				NfsServer:  "10.0.0.10",
+				NfsExport:  "/vol1",
+				ClusterIDs: []string{"cluster-1"},
+				State:      "ATTACHED",
+			},
+		},
+		{
+			stub: &HTTPClientStub{
+				expectedMethod: http.MethodGet,
+				expectedURL:    datastoreURL,
+				responseCode:   http.StatusNotFound,
+				t:              t,
+			},
+			err: ErrDatastoreNotFound,
+		},
+		{
+			stub: &HTTPClientStub{
+				expectedMethod: http.MethodGet,
+				expectedURL:    datastoreURL,
+				responseError:  fmt.Errorf("VMC service down"),
+				t:              t,
+			},
+			err: fmt.Errorf("VMC service down"),
+		},
+	}
+	for _, testCase := range tests {
+		datastoreClient := newTestDatastoreClient(testVmcURL, testOrgID, testAccessToken, testCase.stub)
+		datastore, err := datastoreClient.GetDatastore("ds-1")
+		assert.Equal(t, testCase.err, err)
+		assert.Equal(t, testCase.want, datastore)
+	}
+}
+
+func TestListDatastores(t *testing.T) {
+	stub := &HTTPClientStub{
+		expectedMethod: http.MethodGet,
+		expectedURL:    "https://test.vmc.vmware.com/api/inventory/testOrgID/vmc-aws/datastores?sddc_id=sddc-1",
+		responseCode:   http.StatusOK,
+		responseJSON:   `[{"id":"ds-1","name":"fsx-1"},{"id":"ds-2","name":"fsx-2"}]`,
+		t:              t,
+	}
+	datastores, err := newTestDatastoreClient(testVmcURL, testOrgID, testAccessToken, stub).ListDatastores("sddc-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []NfsDatastore{{ID: "ds-1", Name: "fsx-1"}, {ID: "ds-2", Name: "fsx-2"}}, datastores)
+}
+
+func TestListDatastoresInvalidURL(t *testing.T) {
+	stub := &HTTPClientStub{t: t}
+	datastores, err := newTestDatastoreClient("https://test.vmc.vmware.com\x7f", testOrgID, testAccessToken, stub).ListDatastores("sddc-1")
+	assert.ErrorContains(t, err, "invalid control character in URL")
+	assert.Nil(t, datastores)
+}
+
+func TestAttachDatastore(t *testing.T) {
+	stub := &HTTPClientStub{
+		expectedMethod: http.MethodPost,
+		expectedURL:    "https://test.vmc.vmware.com/api/inventory/testOrgID/vmc-aws/operations",
+		expectedJSON: `{"org_id":"testOrgID","resource_id":"sddc-1","resource_type":"deployment","type":"ATTACH_DATASTORE",` +
+			`"config":{"type":"AwsAttachNfsDatastoreConfig","datastore_name":"fsx-1","nfs_server":"10.0.0.10",` +
+			`"nfs_mount_folder":"/vol1","cluster_ids":["cluster-1"]}}`,
+		responseCode: http.StatusCreated,
+		responseJSON: `{"id":"task-1","type":"ATTACH_DATASTORE","config":{"type":"AwsAttachNfsDatastoreConfig"}}`,
+		t:            t,
+	}
+	config := NewAwsAttachNfsDatastoreConfig("fsx-1", "10.0.0.10", "/vol1", []string{"cluster-1"})
+	taskID, err := newTestDatastoreClient(testVmcURL, testOrgID, testAccessToken, stub).AttachDatastore("sddc-1", *config)
+	assert.NoError(t, err)
+	assert.Equal(t, "task-1", taskID)
+}
+
+func TestDetachDatastore(t *testing.T) {
+	stub := &HTTPClientStub{
+		expectedMethod: http.MethodPost,
+		expectedURL:    "https://test.vmc.vmware.com/api/inventory/testOrgID/vmc-aws/operations",
+		expectedJSON: `{"org_id":"testOrgID","resource_id":"sddc-1","resource_type":"deployment","type":"DETACH_DATASTORE",` +
+			`"config":{"type":"AwsDetachNfsDatastoreConfig","datastore_id":"ds-1","cluster_ids":["cluster-1"]}}`,
+		responseCode: http.StatusBadRequest,
+		responseJSON: `{"message":"datastore is in use"}`,
+		t:            t,
+	}
+	config := NewAwsDetachNfsDatastoreConfig("ds-1", []string{"cluster-1"})
+	_, err := newTestDatastoreClient(testVmcURL, testOrgID, testAccessToken, stub).DetachDatastore("sddc-1", *config)
+	assert.ErrorContains(t, err, "DETACH_DATASTORE response code: 400")
+}
@@ -0,0 +1,79 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package datastore
+
+type NfsDatastore struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	SddcID     string   `json:"sddc_id"`
+	NfsServer  string   `json:"nfs_server"`
+	NfsExport  string   `json:"nfs_mount_folder"`
+	ClusterIDs []string `json:"cluster_ids"`
+	State      string   `json:"state"`
+	Deleted    bool     `json:"deleted"`
+}
+
+type DatastoreOperation struct {
+	ID           string `json:"id,omitempty"`
+	OrgID        string `json:"org_id"`
+	ResourceID   string `json:"resource_id"`
+	ResourceType string `json:"resource_type"`
+	Type         string `json:"type"`
+	Config       Config `json:"config"`
+}
+
+// SddcResourceType the datastore operations are performed on the SDDC.
+const SddcResourceType = "deployment"
+
+func NewDatastoreOperation(orgID string, sddcID string, operationType string, config Config) *DatastoreOperation {
+	return &DatastoreOperation{
+		OrgID:        orgID,
+		ResourceID:   sddcID,
+		ResourceType: SddcResourceType,
+		Type:         operationType,
+		Config:       config,
+	}
+}
+
+type Config struct {
+	Type          string   `json:"type"`
+	DatastoreID   string   `json:"datastore_id,omitempty"`
+	DatastoreName string   `json:"datastore_name,omitempty"`
+	NfsServer     string   `json:"nfs_server,omitempty"`
+	NfsExport     string   `json:"nfs_mount_folder,omitempty"`
+	ClusterIDs    []string `json:"cluster_ids,omitempty"`
+}
+
+const AttachDatastoreOperationType = "ATTACH_DATASTORE"
+
+func NewAwsAttachNfsDatastoreConfig(datastoreName string, nfsServer string, nfsExport string, clusterIDs []string) *Config {
+	return &Config{
+		Type:          "AwsAttachNfsDatastoreConfig",
+		DatastoreName: datastoreName,
+		NfsServer:     nfsServer,
+		NfsExport:     nfsExport,
+		ClusterIDs:    clusterIDs,
+	}
+}
+
+// NewAwsAttachDatastoreToClustersConfig attaches an already attached datastore
+// to additional clusters.
+func NewAwsAttachDatastoreToClustersConfig(datastoreID string, clusterIDs []string) *Config {
+	return &Config{
+		Type:        "AwsAttachNfsDatastoreConfig",
+		DatastoreID: datastoreID,
+		ClusterIDs:  clusterIDs,
+	}
+}
+
+const DetachDatastoreOperationType = "DETACH_DATASTORE"
+
+func NewAwsDetachNfsDatastoreConfig(datastoreID string, clusterIDs []string) *Config {
+	return &Config{
+		Type:        "AwsDetachNfsDatastoreConfig",
+		DatastoreID: datastoreID,
+		ClusterIDs:  clusterIDs,
+	}
+}
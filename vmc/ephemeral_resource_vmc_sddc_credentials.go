@@ -0,0 +1,151 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralSddcCredentials{}
+
+// ephemeralSddcCredentials fetches the vCenter and NSX credentials of an SDDC
+// without persisting them in the plan or the state.
+type ephemeralSddcCredentials struct {
+	providerData *frameworkProviderData
+}
+
+type ephemeralSddcCredentialsModel struct {
+	SddcID                 types.String `tfsdk:"sddc_id"`
+	VcURL                  types.String `tfsdk:"vc_url"`
+	CloudUsername          types.String `tfsdk:"cloud_username"`
+	CloudPassword          types.String `tfsdk:"cloud_password"`
+	NsxtReverseProxyURL    types.String `tfsdk:"nsxt_reverse_proxy_url"`
+	NsxtPrivateURL         types.String `tfsdk:"nsxt_private_url"`
+	NsxtCloudAdmin         types.String `tfsdk:"nsxt_cloudadmin"`
+	NsxtCloudAdminPassword types.String `tfsdk:"nsxt_cloudadmin_password"`
+	NsxtCloudAudit         types.String `tfsdk:"nsxt_cloudaudit"`
+	NsxtCloudAuditPassword types.String `tfsdk:"nsxt_cloudaudit_password"`
+}
+
+func newEphemeralSddcCredentials() ephemeral.EphemeralResource {
+	return &ephemeralSddcCredentials{}
+}
+
+func (r *ephemeralSddcCredentials) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sddc_credentials"
+}
+
+func (r *ephemeralSddcCredentials) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The vCenter and NSX credentials of an SDDC.",
+		Attributes: map[string]schema.Attribute{
+			"sddc_id": schema.StringAttribute{
+				Required:    true,
+				Description: "SDDC identifier.",
+			},
+			"vc_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The vCenter URL.",
+			},
+			"cloud_username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The vCenter cloud admin username.",
+			},
+			"cloud_password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The vCenter cloud admin password.",
+			},
+			"nsxt_reverse_proxy_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NSX reverse proxy URL.",
+			},
+			"nsxt_private_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NSX private URL.",
+			},
+			"nsxt_cloudadmin": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NSX admin user.",
+			},
+			"nsxt_cloudadmin_password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The NSX admin user password.",
+			},
+			"nsxt_cloudaudit": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NSX audit user.",
+			},
+			"nsxt_cloudaudit_password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The NSX audit user password.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralSddcCredentials) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*frameworkProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("Expected *frameworkProviderData, got: %T.", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+func (r *ephemeralSddcCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralSddcCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.providerData == nil {
+		resp.Diagnostics.AddError("Unconfigured provider",
+			"The provider must be configured before the SDDC credentials can be read.")
+		return
+	}
+	connectorWrapper, err := r.providerData.getConnectorWrapper()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure the VMC provider", err.Error())
+		return
+	}
+
+	sddcID := data.SddcID.ValueString()
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SDDC credentials",
+			HandleDataSourceReadError("SDDC", err).Error())
+		return
+	}
+	if sddc.ResourceConfig == nil {
+		resp.Diagnostics.AddError("Error reading SDDC credentials",
+			fmt.Sprintf("SDDC %s has no resource configuration, it may still be deploying.", sddcID))
+		return
+	}
+
+	resourceConfig := sddc.ResourceConfig
+	data.VcURL = types.StringPointerValue(resourceConfig.VcUrl)
+	data.CloudUsername = types.StringPointerValue(resourceConfig.CloudUsername)
+	data.CloudPassword = types.StringPointerValue(resourceConfig.CloudPassword)
+	data.NsxtReverseProxyURL = types.StringPointerValue(resourceConfig.NsxApiPublicEndpointUrl)
+	data.NsxtPrivateURL = types.StringPointerValue(resourceConfig.NsxMgrLoginUrl)
+	data.NsxtCloudAdmin = types.StringPointerValue(resourceConfig.NsxCloudAdmin)
+	data.NsxtCloudAdminPassword = types.StringPointerValue(resourceConfig.NsxCloudAdminPassword)
+	data.NsxtCloudAudit = types.StringPointerValue(resourceConfig.NsxCloudAudit)
+	data.NsxtCloudAuditPassword = types.StringPointerValue(resourceConfig.NsxCloudAuditPassword)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccEphemeralVmcSddcCredentialsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckZerocloud(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		// The echo provider exposes the ephemeral values, which are
+		// otherwise not persisted, for the checks.
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralVmcSddcCredentialsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.sddc_credentials", "data.vc_url"),
+					resource.TestCheckResourceAttrSet("echo.sddc_credentials", "data.cloud_username"),
+					resource.TestCheckResourceAttrSet("echo.sddc_credentials", "data.cloud_password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralVmcSddcCredentialsConfig() string {
+	return fmt.Sprintf(`
+ephemeral "vmc_sddc_credentials" "sddc_credentials" {
+  sddc_id = %q
+}
+
+provider "echo" {
+  data = ephemeral.vmc_sddc_credentials.sddc_credentials
+}
+
+resource "echo" "sddc_credentials" {}
+`, os.Getenv(constants.TestSddcID),
+	)
+}
@@ -104,6 +104,19 @@ func logAPIError(message string, err error) error {
 	return err
 }
 
+// apiErrorMessages returns the individual messages of an InvalidRequest error returned
+// by the VMC API. Any other error is returned as a single message.
+func apiErrorMessages(message string, err error) []string {
+	if vapiError, ok := err.(e.InvalidRequest); ok && vapiError.Data != nil {
+		var typeConverter = bindings.NewTypeConverter()
+		data, convertErr := typeConverter.ConvertToGolang(vapiError.Data, model.ErrorResponseBindingType())
+		if convertErr == nil && len(data.(model.ErrorResponse).ErrorMessages) > 0 {
+			return data.(model.ErrorResponse).ErrorMessages
+		}
+	}
+	return []string{logAPIError(message, err).Error()}
+}
+
 func isNotFoundError(err error) bool {
 	if _, ok := err.(e.NotFound); ok {
 		return true
@@ -20,6 +20,7 @@ func Provider() *schema.Provider {
 			"refresh_token": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc(constants.APIToken, nil),
 				ConflictsWith: []string{"client_id", "client_secret"},
 			},
@@ -33,13 +34,17 @@ func Provider() *schema.Provider {
 			"client_secret": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc(constants.ClientSecret, nil),
 				ConflictsWith: []string{"refresh_token"},
 				RequiredWith:  []string{"client_id"},
 			},
+			// org_id is validated in newConnectorWrapper instead of being
+			// Required, as the schema must not depend on the environment to be
+			// served alongside the plugin framework provider.
 			"org_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(constants.OrgID, nil),
 			},
 			"vmc_url": {
@@ -55,12 +60,20 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"vmc_sddc":          resourceSddc(),
-			"vmc_public_ip":     resourcePublicIP(),
-			"vmc_site_recovery": resourceSiteRecovery(),
-			"vmc_srm_node":      resourceSrmNode(),
-			"vmc_cluster":       resourceCluster(),
-			"vmc_sddc_group":    resourceSddcGroup(),
+			"vmc_sddc":                resourceSddc(),
+			"vmc_public_ip":           resourcePublicIP(),
+			"vmc_public_ip_pool":      resourcePublicIPPool(),
+			"vmc_site_recovery":       resourceSiteRecovery(),
+			"vmc_srm_node":            resourceSrmNode(),
+			"vmc_cluster":             resourceCluster(),
+			"vmc_sddc_group":          resourceSddcGroup(),
+			"vmc_edrs_policy":         resourceEdrsPolicy(),
+			"vmc_microsoft_licensing": resourceMicrosoftLicensing(),
+			"vmc_connected_account":   resourceConnectedAccount(),
+			"vmc_maintenance_window":  resourceMaintenanceWindow(),
+			"vmc_sddc_dns":            resourceSddcDNS(),
+			"vmc_hcx":                 resourceHcx(),
+			"vmc_sddc_nfs_datastore":  resourceSddcNfsDatastore(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -68,6 +81,14 @@ func Provider() *schema.Provider {
 			"vmc_connected_accounts": dataSourceVmcConnectedAccounts(),
 			"vmc_customer_subnets":   dataSourceVmcCustomerSubnets(),
 			"vmc_sddc":               dataSourceVmcSddc(),
+			"vmc_sddc_hosts":         dataSourceVmcSddcHosts(),
+			"vmc_sddcs":              dataSourceVmcSddcs(),
+			"vmc_sddc_templates":     dataSourceVmcSddcTemplates(),
+			"vmc_public_ips":         dataSourceVmcPublicIPs(),
+			"vmc_provisioning_spec":  dataSourceVmcProvisioningSpec(),
+			"vmc_regions":            dataSourceVmcRegions(),
+			"vmc_org_quotas":         dataSourceVmcOrgQuotas(),
+			"vmc_sddc_upgrades":      dataSourceVmcSddcUpgrades(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -75,15 +96,24 @@ func Provider() *schema.Provider {
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	refreshToken := d.Get("refresh_token").(string)
-	clientID := d.Get("client_id").(string)
-	clientSecret := d.Get("client_secret").(string)
+	return newConnectorWrapper(
+		d.Get("refresh_token").(string),
+		d.Get("client_id").(string),
+		d.Get("client_secret").(string),
+		d.Get("org_id").(string),
+		d.Get("vmc_url").(string),
+		d.Get("csp_url").(string))
+}
+
+// newConnectorWrapper returns an authenticated connector.Wrapper for the given
+// provider configuration.
+func newConnectorWrapper(refreshToken, clientID, clientSecret, orgID, vmcURL, cspURL string) (*connector.Wrapper, error) {
 	if len(refreshToken) == 0 && len(clientID) == 0 && len(clientSecret) == 0 {
 		return nil, fmt.Errorf("must provide value for refresh_token or client_id and client_secret")
 	}
-	vmcURL := d.Get("vmc_url").(string)
-	cspURL := d.Get("csp_url").(string)
-	orgID := d.Get("org_id").(string)
+	if len(orgID) == 0 {
+		return nil, fmt.Errorf("must provide value for org_id")
+	}
 	connectorWrapper := connector.Wrapper{
 		RefreshToken: refreshToken,
 		ClientID:     clientID,
@@ -0,0 +1,155 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+// frameworkProvider serves the parts of the provider that the plugin SDK does
+// not support, such as ephemeral resources. Its schema must stay identical to
+// the one of Provider, with which it is muxed.
+type frameworkProvider struct{}
+
+type frameworkProviderModel struct {
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	OrgID        types.String `tfsdk:"org_id"`
+	VmcURL       types.String `tfsdk:"vmc_url"`
+	CspURL       types.String `tfsdk:"csp_url"`
+}
+
+// NewFrameworkProvider returns the plugin framework provider for VMware VMC Console APIs.
+func NewFrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+// frameworkProviderData the provider configuration passed to the ephemeral
+// resources, authenticated lazily.
+type frameworkProviderData struct {
+	refreshToken string
+	clientID     string
+	clientSecret string
+	orgID        string
+	vmcURL       string
+	cspURL       string
+
+	mutex            sync.Mutex
+	connectorWrapper *connector.Wrapper
+}
+
+// getConnectorWrapper returns the connector.Wrapper, authenticating on first use.
+func (data *frameworkProviderData) getConnectorWrapper() (*connector.Wrapper, error) {
+	data.mutex.Lock()
+	defer data.mutex.Unlock()
+	if data.connectorWrapper == nil {
+		connectorWrapper, err := newConnectorWrapper(data.refreshToken, data.clientID, data.clientSecret,
+			data.orgID, data.vmcURL, data.cspURL)
+		if err != nil {
+			return nil, err
+		}
+		data.connectorWrapper = connectorWrapper
+	}
+	return data.connectorWrapper, nil
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "vmc"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"refresh_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"vmc_url": schema.StringAttribute{
+				Optional: true,
+			},
+			"csp_url": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The configuration is not known yet during plan when it depends on other
+	// resources, the ephemeral resources are then opened during apply.
+	for _, value := range []types.String{config.RefreshToken, config.ClientID, config.ClientSecret,
+		config.OrgID, config.VmcURL, config.CspURL} {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	// Authentication is deferred to the first use of the connector, so that
+	// configurations without ephemeral resources do not authenticate twice.
+	resp.EphemeralResourceData = &frameworkProviderData{
+		refreshToken: stringValueOrEnv(config.RefreshToken, constants.APIToken, ""),
+		clientID:     stringValueOrEnv(config.ClientID, constants.ClientID, ""),
+		clientSecret: stringValueOrEnv(config.ClientSecret, constants.ClientSecret, ""),
+		orgID:        stringValueOrEnv(config.OrgID, constants.OrgID, ""),
+		vmcURL:       stringValueOrEnv(config.VmcURL, constants.VmcURL, constants.DefaultVmcURL),
+		cspURL:       stringValueOrEnv(config.CspURL, constants.CspURL, constants.DefaultCspURL),
+	}
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralSddcCredentials,
+	}
+}
+
+// stringValueOrEnv mirrors schema.EnvDefaultFunc, returning the configured
+// value, or else the value of the environment variable, or else defaultValue.
+func stringValueOrEnv(value types.String, envVar string, defaultValue string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+	return defaultValue
+}
@@ -5,9 +5,13 @@
 package vmc
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/constants"
@@ -17,6 +21,29 @@ var testAccProviders map[string]*schema.Provider
 
 var testAccProvider *schema.Provider
 
+// testAccProtoV5ProviderFactories serves the muxed provider, for the tests of
+// ephemeral resources.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"vmc": func() (tfprotov5.ProviderServer, error) {
+		providerServer, err := newTestMuxServer()
+		if err != nil {
+			return nil, err
+		}
+		return providerServer(), nil
+	},
+}
+
+func newTestMuxServer() (func() tfprotov5.ProviderServer, error) {
+	muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+		Provider().GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
 func init() {
 	testAccProvider = Provider()
 	testAccProviders = map[string]*schema.Provider{
@@ -35,6 +62,35 @@ func TestProvider_impl(_ *testing.T) {
 	var _ = Provider()
 }
 
+func TestProviderMuxServer(t *testing.T) {
+	providerServer, err := newTestMuxServer()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	if _, ok := resp.EphemeralResourceSchemas["vmc_sddc_credentials"]; !ok {
+		t.Fatal("vmc_sddc_credentials ephemeral resource is not served")
+	}
+}
+
+func TestFrameworkProviderDataGetConnectorWrapper(t *testing.T) {
+	providerData := &frameworkProviderData{orgID: "org-1"}
+	if _, err := providerData.getConnectorWrapper(); err == nil {
+		t.Fatal("expected an error without refresh_token or client_id and client_secret")
+	}
+	if providerData.connectorWrapper != nil {
+		t.Fatal("expected no connector wrapper to be kept after an error")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv(constants.APIToken); v == "" {
 		t.Fatal(constants.APIToken + " must be set for acceptance tests")
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -60,9 +61,103 @@ func resourceCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 		},
 		Schema: clusterSchema(),
+		CustomizeDiff: customdiff.All(
+			storageCapacityCustomizeDiff,
+			hostQuotaCustomizeDiff("num_hosts"),
+		),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceClusterV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceClusterStateUpgradeV0,
+			},
+		},
+	}
+}
+
+// resourceClusterV0 the Cluster resource before the cluster_info map was replaced
+// by the cluster block.
+func resourceClusterV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: clusterSchemaV0(),
 	}
 }
 
+// clusterSchemaV0 a frozen copy of the attribute types of the version 0 Cluster
+// schema, independent of later changes to clusterSchema.
+func clusterSchemaV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sddc_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"num_hosts": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"host_cpu_cores_count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"host_instance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"edrs_policy_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"enable_edrs": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"min_hosts": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_hosts": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"microsoft_licensing_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mssql_licensing": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"windows_licensing": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"academic_license": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"cluster_info": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	}
+}
+
+// resourceClusterStateUpgradeV0 moves the cluster information from the cluster_info
+// map to the cluster block.
+func resourceClusterStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	rawState["cluster"] = upgradeClusterInfoV0(rawState["cluster_info"], rawState["id"])
+	return rawState, nil
+}
+
 // clusterSchema this helper function extracts the creation of the Cluster schema, so that
 // it's made available for mocking in tests.
 func clusterSchema() map[string]*schema.Schema {
@@ -90,6 +185,14 @@ func clusterSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice(
 				[]string{constants.HostInstancetypeI3, constants.HostInstancetypeI3EN, constants.HostInstancetypeI4I}, false),
 		},
+		"storage_capacity": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(storageCapacities(), false),
+			Description:  "The storage capacity to provision for the cluster, instead of the storage attached to the hosts. Possible values: 15TB, 20TB, 25TB, 30TB, 35TB.",
+		},
 		"edrs_policy_type": {
 			Type: schema.TypeString,
 			// Exact value known after create
@@ -150,9 +253,13 @@ func clusterSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Indicates the desired licensing support, if any, of Microsoft software.",
 		},
-		"cluster_info": {
-			Type:     schema.TypeMap,
-			Computed: true,
+		"remove_host_ids": removeHostIDsSchema(),
+		"cluster_info":    clusterInfoV0Schema("cluster"),
+		"cluster": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        clusterInfoSchema(),
+			Description: "Information about the cluster, such as name, state, ESX hosts, capacity and licensing.",
 		},
 	}
 }
@@ -229,29 +336,20 @@ func resourceClusterRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	d.SetId(clusterID)
-	cluster := map[string]string{}
 	for _, clusterConfig := range sddc.ResourceConfig.Clusters {
 		if clusterConfig.ClusterId == clusterID {
-			cluster["cluster_name"] = *clusterConfig.ClusterName
-			cluster["cluster_state"] = *clusterConfig.ClusterState
-			if clusterConfig.EsxHostInfo != nil {
-				cluster["host_instance_type"] = *clusterConfig.EsxHostInfo.InstanceType
-			}
-
-			if clusterConfig.MsftLicenseConfig != nil {
-				if clusterConfig.MsftLicenseConfig.MssqlLicensing != nil {
-					cluster["mssql_licensing"] = *clusterConfig.MsftLicenseConfig.MssqlLicensing
-				}
-				if clusterConfig.MsftLicenseConfig.WindowsLicensing != nil {
-					cluster["windows_licensing"] = *clusterConfig.MsftLicenseConfig.WindowsLicensing
-				}
+			if err := d.Set("cluster", []map[string]interface{}{flattenClusterInfo(clusterConfig)}); err != nil {
+				return err
 			}
-			if err := d.Set("cluster_info", cluster); err != nil {
+			if err := d.Set("cluster_info", flattenClusterInfoV0(clusterConfig)); err != nil {
 				return err
 			}
 			if err := d.Set("num_hosts", len(clusterConfig.EsxHostList)); err != nil {
 				return err
 			}
+			if err := setStorageCapacity(d, clusterConfig); err != nil {
+				return err
+			}
 			break
 		}
 	}
@@ -330,6 +428,13 @@ func resourceClusterUpdate(d *schema.ResourceData, m interface{}) error {
 			NumHosts:  int64(diffNum),
 			ClusterId: &clusterID,
 		}
+		if action == "remove" {
+			removeHostIDs, err := getRemoveHostIDs(d, connectorWrapper, sddcID, clusterID, diffNum)
+			if err != nil {
+				return HandleUpdateError("Cluster", err)
+			}
+			esxConfig.Esxs = removeHostIDs
+		}
 
 		var unlockFunction = clusterMutationKeyedMutex.Lock(sddcID)
 		hostUpdateTask, err := esxsClient.Create(orgID, sddcID, esxConfig, &action)
@@ -370,8 +475,8 @@ func resourceClusterUpdate(d *schema.ResourceData, m interface{}) error {
 			MinHosts:   &minHosts,
 			MaxHosts:   &maxHosts,
 		}
-		if policyType == constants.StorageScaleUpPolicyType && !enableEDRS {
-			return fmt.Errorf("EDRS policy %s is the default and cannot be disabled", constants.StorageScaleUpPolicyType)
+		if err := validateEdrsPolicy(policyType, enableEDRS, int(minHosts), int(maxHosts)); err != nil {
+			return err
 		}
 		var unlockFunction = clusterMutationKeyedMutex.Lock(sddcID)
 		edrsPolicyUpdateTask, err := edrsPolicyClient.Post(orgID, sddcID, clusterID, *edrsPolicy)
@@ -449,5 +554,6 @@ func buildClusterConfig(d *schema.ResourceData) (*model.ClusterConfig, error) {
 		HostCpuCoresCount: &hostCPUCoresCount,
 		HostInstanceType:  &hostInstanceType,
 		MsftLicenseConfig: msftLicensingConfig,
+		StorageCapacity:   storageCapacityPtr(d.Get("storage_capacity").(string)),
 	}, nil
 }
@@ -5,6 +5,7 @@
 package vmc
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -72,7 +73,7 @@ func TestAccResourceVmcClusterZerocloud(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// "microsoft_licensing_config" and "host_instance_type" are set in the
-				// cluster_info map, not on the cluster resource itself.
+				// cluster block, not on the cluster resource itself.
 				ImportStateVerifyIgnore: []string{"microsoft_licensing_config", "host_instance_type", "edrs_policy_type", "enable_edrs", "max_hosts", "min_hosts"},
 			},
 		},
@@ -102,7 +103,7 @@ func TestAccResourceVmcClusterRequiredFieldsZerocloud(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// "microsoft_licensing_config" and "host_instance_type" are set in the
-				// cluster_info map, not on the cluster resource itself.
+				// cluster block, not on the cluster resource itself.
 				ImportStateVerifyIgnore: []string{"microsoft_licensing_config", "host_instance_type", "edrs_policy_type", "enable_edrs", "max_hosts", "min_hosts"},
 			},
 		},
@@ -351,3 +352,52 @@ func TestBuildClusterConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildClusterConfigStorageCapacity(t *testing.T) {
+	config := map[string]interface{}{
+		"num_hosts":          constants.MinHosts,
+		"host_instance_type": constants.HostInstancetypeI3EN,
+		"storage_capacity":   "25TB",
+	}
+	got, err := buildClusterConfig(schema.TestResourceDataRaw(t, clusterSchema(), config))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(25005), *got.StorageCapacity)
+
+	delete(config, "storage_capacity")
+	got, err = buildClusterConfig(schema.TestResourceDataRaw(t, clusterSchema(), config))
+	assert.NoError(t, err)
+	assert.Nil(t, got.StorageCapacity)
+}
+
+func TestResourceClusterStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":      "cluster-1",
+		"sddc_id": "sddc-1",
+		"cluster_info": map[string]interface{}{
+			"cluster_name":       "Cluster-2",
+			"cluster_state":      "READY",
+			"host_instance_type": "i3en.metal",
+		},
+	}
+	expected := map[string]interface{}{
+		"id":      "cluster-1",
+		"sddc_id": "sddc-1",
+		"cluster_info": map[string]interface{}{
+			"cluster_name":       "Cluster-2",
+			"cluster_state":      "READY",
+			"host_instance_type": "i3en.metal",
+		},
+		"cluster": []interface{}{
+			map[string]interface{}{
+				"cluster_id":         "cluster-1",
+				"cluster_name":       "Cluster-2",
+				"cluster_state":      "READY",
+				"host_instance_type": "i3en.metal",
+			},
+		},
+	}
+
+	got, err := resourceClusterStateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, got)
+}
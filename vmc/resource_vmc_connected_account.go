@@ -0,0 +1,216 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/account_link"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func resourceConnectedAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConnectedAccountCreate,
+		Read:          resourceConnectedAccountRead,
+		Update:        resourceConnectedAccountUpdate,
+		Delete:        resourceConnectedAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceConnectedAccountImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: connectedAccountSchema(),
+	}
+}
+
+// connectedAccountSchema this helper function extracts the creation of the
+// connected account schema, so that it's made available for mocking in tests.
+func connectedAccountSchema() map[string]*schema.Schema {
+	connectedAccountSchema := connectedAccountAttributesSchema()
+	connectedAccountSchema["account_number"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{12}$`), "must be a 12 digits AWS account number"),
+		Description:  "The AWS account number to connect.",
+	}
+	connectedAccountSchema["provider_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  constants.AwsProviderType,
+		ValidateFunc: validation.StringInSlice([]string{
+			constants.AwsProviderType, constants.ZeroCloudProviderType}, false),
+		Description: "The cloud provider of the connected account (AWS or ZEROCLOUD). Default : AWS.",
+	}
+	connectedAccountSchema["wait_for_connection"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Wait for the CloudFormation template to be run in the AWS account and the account to be connected. Default : true.",
+	}
+	connectedAccountSchema["force_delete"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Disconnect the AWS account even if SDDCs are linked to it. Default : false.",
+	}
+	connectedAccountSchema["template_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the CloudFormation template linking the AWS account.",
+	}
+	connectedAccountSchema["template_execution_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the AWS console creating the CloudFormation stack linking the AWS account.",
+	}
+	connectedAccountSchema["link_expiration"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The expiration date of the template URLs.",
+	}
+	return connectedAccountSchema
+}
+
+func resourceConnectedAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	accountNumber := d.Get("account_number").(string)
+
+	linkRequest, err := orgs.NewAccountLinkClient(connectorWrapper).Get(orgID)
+	if err != nil {
+		return diag.FromErr(HandleCreateError("Connected Account", err))
+	}
+	d.SetId(accountNumber)
+	if err := d.Set("template_url", linkRequest.TemplateUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("template_execution_url", linkRequest.TemplateExecutionUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if linkRequest.ExpirationDate != nil {
+		if err := d.Set("link_expiration", linkRequest.ExpirationDate.String()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	templateExecutionURL := stringValue(linkRequest.TemplateExecutionUrl)
+
+	if !d.Get("wait_for_connection").(bool) {
+		if err := resourceConnectedAccountRead(d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		if d.Get("connected_account_id").(string) != "" {
+			return nil
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("AWS account %s is not connected yet", accountNumber),
+			Detail:   "Run the CloudFormation template in the AWS account to connect it: " + templateExecutionURL,
+		}}
+	}
+
+	log.Printf("[INFO] Waiting for AWS account %s to be connected, run the CloudFormation template: %s", accountNumber, templateExecutionURL)
+	providerType := d.Get("provider_type").(string)
+	connectedAccountsClient := account_link.NewConnectedAccountsClient(connectorWrapper)
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		accounts, err := connectedAccountsClient.Get(orgID, &providerType)
+		if err != nil {
+			return retry.NonRetryableError(HandleListError("Connected Accounts", err))
+		}
+		account := findConnectedAccount(accounts, accountNumber)
+		if account == nil || stringValue(account.State) != model.AwsCustomerConnectedAccount_STATE_ACTIVE {
+			return retry.RetryableError(fmt.Errorf("AWS account %s is not connected, run the CloudFormation template in the AWS account: %s",
+				accountNumber, templateExecutionURL))
+		}
+		return nil
+	})
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(HandleCreateError("Connected Account", err))
+	}
+	return diag.FromErr(resourceConnectedAccountRead(d, m))
+}
+
+// resourceConnectedAccountImport imports the connected AWS account with the given
+// account number, the other arguments being set to their default.
+func resourceConnectedAccountImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("account_number", d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("provider_type", constants.AwsProviderType); err != nil {
+		return nil, err
+	}
+	if err := d.Set("wait_for_connection", true); err != nil {
+		return nil, err
+	}
+	if err := d.Set("force_delete", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceConnectedAccountRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	accountNumber := d.Id()
+	providerType := d.Get("provider_type").(string)
+
+	accounts, err := account_link.NewConnectedAccountsClient(connectorWrapper).Get(orgID, &providerType)
+	if err != nil {
+		return HandleReadError(d, "Connected Account", accountNumber, err)
+	}
+	account := findConnectedAccount(accounts, accountNumber)
+	if account == nil {
+		if d.Get("connected_account_id").(string) != "" {
+			log.Printf("Connected account for AWS account %s not found", accountNumber)
+			d.SetId("")
+		}
+		// else the CloudFormation template hasn't been run yet
+		return nil
+	}
+	for key, value := range flattenConnectedAccount(*account) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceConnectedAccountUpdate only wait_for_connection and force_delete can
+// be updated, they are local to the resource.
+func resourceConnectedAccountUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceConnectedAccountRead(d, m)
+}
+
+func resourceConnectedAccountDelete(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	connectedAccountID := d.Get("connected_account_id").(string)
+	if connectedAccountID == "" {
+		d.SetId("")
+		return nil
+	}
+	forceDelete := d.Get("force_delete").(bool)
+	_, err := account_link.NewConnectedAccountsClient(connectorWrapper).Delete(connectorWrapper.OrgID, connectedAccountID, &forceDelete)
+	if err != nil {
+		return HandleDeleteError("Connected Account", connectedAccountID, err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,42 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccResourceVmcConnectedAccountZerocloud requests the link of an AWS account
+// that is never connected, so that destroying the resource leaves the connected
+// accounts of the organization untouched.
+func TestAccResourceVmcConnectedAccountZerocloud(t *testing.T) {
+	resourceName := "vmc_connected_account.account_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcConnectedAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "000000000000"),
+					resource.TestCheckResourceAttrSet(resourceName, "template_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "template_execution_url"),
+					resource.TestCheckResourceAttr(resourceName, "connected_account_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccVmcConnectedAccountConfig() string {
+	return `
+resource "vmc_connected_account" "account_1" {
+  account_number      = "000000000000"
+  wait_for_connection = false
+}
+`
+}
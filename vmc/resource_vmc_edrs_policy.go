@@ -0,0 +1,224 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	autoscalercluster "github.com/vmware/vsphere-automation-sdk-go/services/vmc/autoscaler/api/orgs/sddcs/clusters"
+	autoscalermodel "github.com/vmware/vsphere-automation-sdk-go/services/vmc/autoscaler/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+	"github.com/vmware/terraform-provider-vmc/vmc/task"
+)
+
+func resourceEdrsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEdrsPolicyCreate,
+		Read:   resourceEdrsPolicyRead,
+		Update: resourceEdrsPolicyUpdate,
+		Delete: resourceEdrsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importClusterScopedResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: edrsPolicySchema(),
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			return validateEdrsPolicy(d.Get("policy_type").(string), d.Get("enable_edrs").(bool),
+				d.Get("min_hosts").(int), d.Get("max_hosts").(int))
+		},
+	}
+}
+
+// edrsPolicySchema this helper function extracts the creation of the EDRS policy
+// schema, so that it's made available for mocking in tests.
+func edrsPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sddc_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "SDDC identifier.",
+		},
+		"cluster_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "Identifier of the cluster the EDRS policy applies to.",
+		},
+		"policy_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  constants.StorageScaleUpPolicyType,
+			ValidateFunc: validation.StringInSlice(
+				[]string{constants.StorageScaleUpPolicyType, constants.CostPolicyType, constants.PerformancePolicyType, constants.RapidScaleUpPolicyType}, false),
+			Description: "The EDRS policy type. This can either be 'cost', 'performance', 'storage-scaleup' or 'rapid-scaleup'. Default : storage-scaleup.",
+		},
+		"enable_edrs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "True if EDRS is enabled. Default : true.",
+		},
+		"min_hosts": {
+			Type: schema.TypeInt,
+			// Exact value known after create
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(constants.MinHosts, constants.MaxHosts),
+			Description:  "The minimum number of hosts that the cluster can scale in to.",
+		},
+		"max_hosts": {
+			Type: schema.TypeInt,
+			// Exact value known after create
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(constants.MinHosts, constants.MaxHosts),
+			Description:  "The maximum number of hosts that the cluster can scale out to.",
+		},
+	}
+}
+
+func resourceEdrsPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+	if err := updateEdrsPolicy(d, m, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return HandleCreateError("EDRS Policy", err)
+	}
+	d.SetId(clusterID)
+	return resourceEdrsPolicyRead(d, m)
+}
+
+func resourceEdrsPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcID := d.Get("sddc_id").(string)
+	clusterID := d.Id()
+
+	edrsPolicyClient := autoscalercluster.NewEdrsPolicyClient(connectorWrapper)
+	edrsPolicy, err := edrsPolicyClient.Get(orgID, sddcID, clusterID)
+	if err != nil {
+		return HandleReadError(d, "EDRS Policy", clusterID, err)
+	}
+	if err := d.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	if err := d.Set("policy_type", edrsPolicy.PolicyType); err != nil {
+		return err
+	}
+	if err := d.Set("enable_edrs", edrsPolicy.EnableEdrs); err != nil {
+		return err
+	}
+	if err := d.Set("min_hosts", edrsPolicy.MinHosts); err != nil {
+		return err
+	}
+	if err := d.Set("max_hosts", edrsPolicy.MaxHosts); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceEdrsPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := updateEdrsPolicy(d, m, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return HandleUpdateError("EDRS Policy", err)
+	}
+	return resourceEdrsPolicyRead(d, m)
+}
+
+// resourceEdrsPolicyDelete reverts the cluster to the default EDRS policy, as
+// the EDRS policy of a cluster cannot be deleted.
+func resourceEdrsPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	if err := d.Set("policy_type", constants.StorageScaleUpPolicyType); err != nil {
+		return err
+	}
+	if err := d.Set("enable_edrs", true); err != nil {
+		return err
+	}
+	if err := updateEdrsPolicy(d, m, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return HandleDeleteError("EDRS Policy", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// updateEdrsPolicy posts the EDRS policy of the resource and waits for the
+// autoscaler task to finish.
+func updateEdrsPolicy(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcID := d.Get("sddc_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	policyType := d.Get("policy_type").(string)
+	enableEDRS := d.Get("enable_edrs").(bool)
+	minHosts := d.Get("min_hosts").(int)
+	maxHosts := d.Get("max_hosts").(int)
+	if err := validateEdrsPolicy(policyType, enableEDRS, minHosts, maxHosts); err != nil {
+		return err
+	}
+
+	sddc, err := GetSddc(connectorWrapper, orgID, sddcID)
+	if err != nil {
+		return err
+	}
+	if sddc.SddcType != nil && *sddc.SddcType == constants.OneNodeSddcType {
+		return fmt.Errorf("EDRS policy cannot be updated for SDDC with type %s", constants.OneNodeSddcType)
+	}
+
+	edrsPolicy := autoscalermodel.EdrsPolicy{
+		EnableEdrs: enableEDRS,
+		PolicyType: &policyType,
+	}
+	// min_hosts and max_hosts are left to the autoscaler until they are known
+	if minHosts > 0 {
+		minHostsCount := int64(minHosts)
+		edrsPolicy.MinHosts = &minHostsCount
+	}
+	if maxHosts > 0 {
+		maxHostsCount := int64(maxHosts)
+		edrsPolicy.MaxHosts = &maxHostsCount
+	}
+
+	var unlockFunction = clusterMutationKeyedMutex.Lock(sddcID)
+	edrsPolicyClient := autoscalercluster.NewEdrsPolicyClient(connectorWrapper)
+	edrsPolicyUpdateTask, err := edrsPolicyClient.Post(orgID, sddcID, clusterID, edrsPolicy)
+	if err != nil {
+		unlockFunction()
+		return err
+	}
+	return retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		return task.RetryTaskUntilFinished(connectorWrapper,
+			func() (model.Task, error) {
+				return task.GetAutoscalerTask(connectorWrapper, edrsPolicyUpdateTask.Id)
+			},
+			"error updating EDRS policy configuration "+clusterID,
+			func(_ model.Task) {
+				unlockFunction()
+			})
+	})
+}
+
+// validateEdrsPolicy checks an EDRS policy before it is sent to the autoscaler.
+// min_hosts and max_hosts are 0 when they are not known yet.
+func validateEdrsPolicy(policyType string, enableEDRS bool, minHosts int, maxHosts int) error {
+	if policyType == constants.StorageScaleUpPolicyType && !enableEDRS {
+		return fmt.Errorf("EDRS policy %s is the default and cannot be disabled", constants.StorageScaleUpPolicyType)
+	}
+	if minHosts > 0 && maxHosts > 0 && minHosts > maxHosts {
+		return fmt.Errorf("min_hosts (%d) cannot be greater than max_hosts (%d)", minHosts, maxHosts)
+	}
+	return nil
+}
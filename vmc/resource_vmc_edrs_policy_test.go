@@ -0,0 +1,95 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	autoscalercluster "github.com/vmware/vsphere-automation-sdk-go/services/vmc/autoscaler/api/orgs/sddcs/clusters"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccResourceVmcEdrsPolicyZerocloud(t *testing.T) {
+	resourceName := "vmc_edrs_policy.edrs_policy_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckZerocloud(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVmcEdrsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcEdrsPolicyConfig(constants.CostPolicyType, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_type", constants.CostPolicyType),
+					resource.TestCheckResourceAttr(resourceName, "enable_edrs", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_hosts", "4"),
+				),
+			},
+			{
+				Config: testAccVmcEdrsPolicyConfig(constants.PerformancePolicyType, 6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_type", constants.PerformancePolicyType),
+					resource.TestCheckResourceAttr(resourceName, "max_hosts", "6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccVmcClusterResourceImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckVmcEdrsPolicyDestroy(s *terraform.State) error {
+	connectorWrapper := testAccProvider.Meta().(*connector.Wrapper)
+	edrsPolicyClient := autoscalercluster.NewEdrsPolicyClient(connectorWrapper)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vmc_edrs_policy" {
+			continue
+		}
+		edrsPolicy, err := edrsPolicyClient.Get(connectorWrapper.OrgID, rs.Primary.Attributes["sddc_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if *edrsPolicy.PolicyType != constants.StorageScaleUpPolicyType {
+			return fmt.Errorf("EDRS policy of cluster %s was not reverted to %s", rs.Primary.ID, constants.StorageScaleUpPolicyType)
+		}
+	}
+	return nil
+}
+
+func testAccVmcEdrsPolicyConfig(policyType string, maxHosts int) string {
+	return fmt.Sprintf(`
+data "vmc_sddc" "sddc_1" {
+  sddc_id = %q
+}
+
+resource "vmc_edrs_policy" "edrs_policy_1" {
+  sddc_id     = data.vmc_sddc.sddc_1.id
+  cluster_id  = data.vmc_sddc.sddc_1.clusters[0].cluster_id
+  policy_type = %q
+  enable_edrs = true
+  min_hosts   = 2
+  max_hosts   = %d
+}
+`, os.Getenv(constants.TestSddcID), policyType, maxHosts)
+}
+
+func TestValidateEdrsPolicy(t *testing.T) {
+	assert.NoError(t, validateEdrsPolicy(constants.CostPolicyType, false, 0, 0))
+	assert.NoError(t, validateEdrsPolicy(constants.StorageScaleUpPolicyType, true, 2, 2))
+	assert.EqualError(t, validateEdrsPolicy(constants.StorageScaleUpPolicyType, false, 0, 0),
+		"EDRS policy storage-scaleup is the default and cannot be disabled")
+	assert.EqualError(t, validateEdrsPolicy(constants.PerformancePolicyType, true, 8, 4),
+		"min_hosts (8) cannot be greater than max_hosts (4)")
+}
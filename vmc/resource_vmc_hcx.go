@@ -0,0 +1,133 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+const hcxAddonName = "hcx"
+
+func resourceHcx() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHcxCreate,
+		Read:   resourceHcxRead,
+		Delete: resourceHcxDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "SDDC identifier.",
+			},
+		},
+	}
+}
+
+func resourceHcxCreate(d *schema.ResourceData, m interface{}) error {
+	sddcID := d.Get("sddc_id").(string)
+	if err := setHcxEnabled(m, sddcID, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return HandleCreateError("HCX", err)
+	}
+	d.SetId(sddcID)
+	return resourceHcxRead(d, m)
+}
+
+func resourceHcxRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcID := d.Id()
+	sddc, err := GetSddc(connectorWrapper, orgID, sddcID)
+	if err != nil {
+		return HandleReadError(d, "HCX", sddcID, err)
+	}
+	if *sddc.SddcState == "DELETED" {
+		log.Printf("Unable to retrieve SDDC with ID %s", sddc.Id)
+		d.SetId("")
+		return nil
+	}
+	enablements, err := sddcs.NewEnablementsClient(connectorWrapper).List(orgID, sddcID)
+	if err != nil {
+		return HandleReadError(d, "HCX", sddcID, err)
+	}
+	if !isAddonEnabled(enablements, hcxAddonName) {
+		log.Printf("HCX is not activated on SDDC %s", sddcID)
+		d.SetId("")
+		return nil
+	}
+	return d.Set("sddc_id", sddcID)
+}
+
+func resourceHcxDelete(d *schema.ResourceData, m interface{}) error {
+	sddcID := d.Id()
+	if err := setHcxEnabled(m, sddcID, false, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return HandleDeleteError("HCX", sddcID, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// setHcxEnabled activates or deactivates the HCX add-on of the SDDC. The
+// enablements API does not return a task, so the add-on state is polled until
+// it matches. An add-on already in the requested state is not an error.
+func setHcxEnabled(m interface{}, sddcID string, enabled bool, timeout time.Duration) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	enablementsClient := sddcs.NewEnablementsClient(connectorWrapper)
+
+	action := sddcs.Enablements_ENABLE_DISABLE_ADDON_ACTION_DISABLE
+	if enabled {
+		action = sddcs.Enablements_ENABLE_DISABLE_ADDON_ACTION_ENABLE
+	}
+	err := enablementsClient.EnableDisableAddon(orgID, sddcID, hcxAddonName, action)
+	if _, ok := err.(errors.AlreadyInDesiredState); ok {
+		log.Printf("HCX add-on of SDDC %s is already in the desired state, action %s skipped", sddcID, action)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		enablements, err := enablementsClient.List(orgID, sddcID)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if isAddonEnabled(enablements, hcxAddonName) != enabled {
+			return retry.RetryableError(fmt.Errorf("expected HCX add-on of SDDC %s to be %sd", sddcID, action))
+		}
+		return nil
+	})
+}
+
+// isAddonEnabled reports whether the named add-on is enabled.
+func isAddonEnabled(enablements []model.EnablementInfo, name string) bool {
+	for _, enablement := range enablements {
+		if strings.EqualFold(enablement.Name, name) {
+			return enablement.Enabled
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccResourceVmcHcxZerocloud(t *testing.T) {
+	resourceName := "vmc_hcx.hcx_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckZerocloud(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVmcHcxDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcHcxConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sddc_id", os.Getenv(constants.TestSddcID)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckVmcHcxDestroy(s *terraform.State) error {
+	connectorWrapper := testAccProvider.Meta().(*connector.Wrapper)
+	enablementsClient := sddcs.NewEnablementsClient(connectorWrapper)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vmc_hcx" {
+			continue
+		}
+		enablements, err := enablementsClient.List(connectorWrapper.OrgID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if isAddonEnabled(enablements, hcxAddonName) {
+			return fmt.Errorf("HCX is still activated on SDDC %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccVmcHcxConfig() string {
+	return fmt.Sprintf(`
+resource "vmc_hcx" "hcx_1" {
+  sddc_id = %q
+}
+`, os.Getenv(constants.TestSddcID),
+	)
+}
+
+func TestIsAddonEnabled(t *testing.T) {
+	enablements := []model.EnablementInfo{
+		{Name: "nsx-advanced", Enabled: false},
+		{Name: "HCX", Enabled: true},
+	}
+	assert.True(t, isAddonEnabled(enablements, hcxAddonName))
+	assert.False(t, isAddonEnabled(enablements, "nsx-advanced"))
+	assert.False(t, isAddonEnabled(nil, hcxAddonName))
+}
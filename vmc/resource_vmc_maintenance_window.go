@@ -0,0 +1,200 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/tbrs"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMaintenanceWindowCreate,
+		Read:   resourceMaintenanceWindowRead,
+		Update: resourceMaintenanceWindowUpdate,
+		Delete: resourceMaintenanceWindowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema:        maintenanceWindowSchema(),
+		CustomizeDiff: maintenanceWindowCustomizeDiff,
+	}
+}
+
+// maintenanceWindowCustomizeDiff the support window is selected either by
+// identifier or by start day and hour, changing one changes the other.
+func maintenanceWindowCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("support_window_id") {
+		for _, key := range []string{"start_day", "start_hour", "duration_hours"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if d.HasChanges("start_day", "start_hour") {
+		for _, key := range []string{"support_window_id", "duration_hours"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// maintenanceWindowSchema this helper function extracts the creation of the
+// maintenance window schema, so that it's made available for mocking in tests.
+func maintenanceWindowSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sddc_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "SDDC identifier.",
+		},
+		"support_window_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"support_window_id", "start_day"},
+			Description:  "Identifier of the support window to move the SDDC to.",
+		},
+		"start_day": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				model.SupportWindow_START_DAY_MONDAY, model.SupportWindow_START_DAY_TUESDAY,
+				model.SupportWindow_START_DAY_WEDNESDAY, model.SupportWindow_START_DAY_THURSDAY,
+				model.SupportWindow_START_DAY_FRIDAY, model.SupportWindow_START_DAY_SATURDAY,
+				model.SupportWindow_START_DAY_SUNDAY}, true),
+			DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+				return strings.EqualFold(o, n)
+			},
+			RequiredWith: []string{"start_hour"},
+			Description:  "Preferred day of the week the maintenance window starts, e.g. SATURDAY.",
+		},
+		"start_hour": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 23),
+			RequiredWith: []string{"start_day"},
+			Description:  "Preferred hour of the day (UTC) the maintenance window starts.",
+		},
+		"duration_hours": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Duration of the maintenance window in hours.",
+		},
+	}
+}
+
+func resourceMaintenanceWindowCreate(d *schema.ResourceData, m interface{}) error {
+	sddcID := d.Get("sddc_id").(string)
+	if err := moveSddcToSupportWindow(d, m); err != nil {
+		return HandleCreateError("Maintenance Window", err)
+	}
+	d.SetId(sddcID)
+	return resourceMaintenanceWindowRead(d, m)
+}
+
+func resourceMaintenanceWindowRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	sddcID := d.Id()
+	supportWindows, err := tbrs.NewSupportWindowClient(connectorWrapper).Get(connectorWrapper.OrgID, nil, nil)
+	if err != nil {
+		return HandleReadError(d, "Maintenance Window", sddcID, err)
+	}
+	for _, supportWindow := range supportWindows {
+		if !slices.Contains(supportWindow.Sddcs, sddcID) {
+			continue
+		}
+		if err := d.Set("sddc_id", sddcID); err != nil {
+			return err
+		}
+		if err := d.Set("support_window_id", supportWindow.SupportWindowId); err != nil {
+			return err
+		}
+		if err := d.Set("start_day", supportWindow.StartDay); err != nil {
+			return err
+		}
+		if err := d.Set("start_hour", supportWindow.StartHour); err != nil {
+			return err
+		}
+		return d.Set("duration_hours", supportWindow.DurationHours)
+	}
+	log.Printf("Unable to find the support window of SDDC with ID %s", sddcID)
+	d.SetId("")
+	return nil
+}
+
+func resourceMaintenanceWindowUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChanges("support_window_id", "start_day", "start_hour") {
+		if err := moveSddcToSupportWindow(d, m); err != nil {
+			return HandleUpdateError("Maintenance Window", err)
+		}
+	}
+	return resourceMaintenanceWindowRead(d, m)
+}
+
+// resourceMaintenanceWindowDelete only removes the resource from the state, an
+// SDDC always belongs to a support window.
+func resourceMaintenanceWindowDelete(d *schema.ResourceData, _ interface{}) error {
+	log.Printf("[INFO] SDDC with ID %s stays in its current support window", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// moveSddcToSupportWindow moves the SDDC to the support window of the resource,
+// selected by identifier or by start day and hour.
+func moveSddcToSupportWindow(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcID := d.Get("sddc_id").(string)
+	supportWindowClient := tbrs.NewSupportWindowClient(connectorWrapper)
+
+	supportWindowID := d.Get("support_window_id").(string)
+	if !d.GetRawConfig().GetAttr("start_day").IsNull() {
+		supportWindows, err := supportWindowClient.Get(orgID, nil, nil)
+		if err != nil {
+			return err
+		}
+		supportWindowID, err = findSupportWindow(supportWindows, sddcID, d.Get("start_day").(string), d.Get("start_hour").(int))
+		if err != nil {
+			return err
+		}
+	}
+	_, err := supportWindowClient.Put(orgID, supportWindowID, model.SddcId{SddcId: &sddcID})
+	return err
+}
+
+// findSupportWindow the identifier of a support window starting on the given day
+// and hour, either the one of the SDDC or one with seats available.
+func findSupportWindow(supportWindows []model.SupportWindow, sddcID string, startDay string, startHour int) (string, error) {
+	for _, supportWindow := range supportWindows {
+		if !strings.EqualFold(stringValue(supportWindow.StartDay), startDay) || int(int64Value(supportWindow.StartHour)) != startHour {
+			continue
+		}
+		if slices.Contains(supportWindow.Sddcs, sddcID) || int64Value(supportWindow.Seats) > 0 {
+			return stringValue(supportWindow.SupportWindowId), nil
+		}
+	}
+	return "", fmt.Errorf("no support window with seats available starts on %s at %d:00", strings.ToUpper(startDay), startHour)
+}
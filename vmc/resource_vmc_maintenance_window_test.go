@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccResourceVmcMaintenanceWindowZerocloud(t *testing.T) {
+	resourceName := "vmc_maintenance_window.window_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcMaintenanceWindowConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "start_day", model.SupportWindow_START_DAY_SUNDAY),
+					resource.TestCheckResourceAttr(resourceName, "start_hour", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "support_window_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "duration_hours"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVmcMaintenanceWindowConfig() string {
+	return fmt.Sprintf(`
+resource "vmc_maintenance_window" "window_1" {
+  sddc_id    = %q
+  start_day  = "SUNDAY"
+  start_hour = 2
+}
+`, os.Getenv(constants.TestSddcID),
+	)
+}
+
+func TestFindSupportWindow(t *testing.T) {
+	newSupportWindow := func(id string, startDay string, startHour int64, seats int64, sddcs ...string) model.SupportWindow {
+		return model.SupportWindow{
+			SupportWindowId: &id,
+			StartDay:        &startDay,
+			StartHour:       &startHour,
+			Seats:           &seats,
+			Sddcs:           sddcs,
+		}
+	}
+	supportWindows := []model.SupportWindow{
+		newSupportWindow("window-1", "SUNDAY", 2, 0, "sddc-1"),
+		newSupportWindow("window-2", "SUNDAY", 2, 3),
+		newSupportWindow("window-3", "SATURDAY", 22, 0),
+	}
+
+	supportWindowID, err := findSupportWindow(supportWindows, "sddc-1", "sunday", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "window-1", supportWindowID)
+
+	supportWindowID, err = findSupportWindow(supportWindows, "sddc-2", "SUNDAY", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "window-2", supportWindowID)
+
+	_, err = findSupportWindow(supportWindows, "sddc-2", "saturday", 22)
+	assert.EqualError(t, err, "no support window with seats available starts on SATURDAY at 22:00")
+}
@@ -0,0 +1,211 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs/clusters/msft_licensing"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+	"github.com/vmware/terraform-provider-vmc/vmc/task"
+)
+
+func resourceMicrosoftLicensing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMicrosoftLicensingCreate,
+		Read:   resourceMicrosoftLicensingRead,
+		Update: resourceMicrosoftLicensingUpdate,
+		Delete: resourceMicrosoftLicensingDelete,
+		Importer: &schema.ResourceImporter{
+			State: importClusterScopedResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: microsoftLicensingSchema(),
+	}
+}
+
+// microsoftLicensingSchema this helper function extracts the creation of the
+// Microsoft licensing schema, so that it's made available for mocking in tests.
+func microsoftLicensingSchema() map[string]*schema.Schema {
+	licensingStatuses := []string{constants.LicenseConfigEnabled, constants.LicenseConfigDisabled,
+		constants.CapitalLicenseConfigEnabled, constants.CapitalLicenseConfigDisabled}
+	return map[string]*schema.Schema{
+		"sddc_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "SDDC identifier.",
+		},
+		"cluster_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "Identifier of the cluster the Microsoft licensing applies to.",
+		},
+		"mssql_licensing": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licensingStatuses, false),
+			DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+				return strings.EqualFold(o, n)
+			},
+			Description: "The status of MSSQL licensing for the cluster. Possible values : enabled, ENABLED, disabled, DISABLED.",
+		},
+		"windows_licensing": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licensingStatuses, false),
+			DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+				return strings.EqualFold(o, n)
+			},
+			Description: "The status of Windows licensing for the cluster. Possible values : enabled, ENABLED, disabled, DISABLED.",
+		},
+		"academic_license": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Flag to identify if it is Academic Standard or Commercial Standard License.",
+		},
+	}
+}
+
+func resourceMicrosoftLicensingCreate(d *schema.ResourceData, m interface{}) error {
+	clusterID := d.Get("cluster_id").(string)
+	if err := publishMicrosoftLicensing(d, m, expandMicrosoftLicensing(d), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return HandleCreateError("Microsoft Licensing", err)
+	}
+	d.SetId(clusterID)
+	return resourceMicrosoftLicensingRead(d, m)
+}
+
+func resourceMicrosoftLicensingRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	sddcID := d.Get("sddc_id").(string)
+	clusterID := d.Id()
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		return HandleReadError(d, "Microsoft Licensing", clusterID, err)
+	}
+	if *sddc.SddcState == "DELETED" || sddc.ResourceConfig == nil {
+		log.Printf("Unable to retrieve SDDC with ID %s", sddc.Id)
+		d.SetId("")
+		return nil
+	}
+	for _, cluster := range sddc.ResourceConfig.Clusters {
+		if cluster.ClusterId == clusterID {
+			if err := d.Set("cluster_id", clusterID); err != nil {
+				return err
+			}
+			return setMicrosoftLicensing(d, cluster.MsftLicenseConfig)
+		}
+	}
+	log.Printf("Unable to retrieve cluster with ID %s", clusterID)
+	d.SetId("")
+	return nil
+}
+
+func resourceMicrosoftLicensingUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := publishMicrosoftLicensing(d, m, expandMicrosoftLicensing(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return HandleUpdateError("Microsoft Licensing", err)
+	}
+	return resourceMicrosoftLicensingRead(d, m)
+}
+
+// resourceMicrosoftLicensingDelete disables the Microsoft licensing of the cluster.
+func resourceMicrosoftLicensingDelete(d *schema.ResourceData, m interface{}) error {
+	disabled := constants.CapitalLicenseConfigDisabled
+	academicLicense := false
+	msftLicenseConfig := model.MsftLicensingConfig{
+		MssqlLicensing:   &disabled,
+		WindowsLicensing: &disabled,
+		AcademicLicense:  &academicLicense,
+	}
+	if err := publishMicrosoftLicensing(d, m, msftLicenseConfig, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return HandleDeleteError("Microsoft Licensing", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// expandMicrosoftLicensing builds the licensing configuration of the resource,
+// the VMC API expecting upper case statuses.
+func expandMicrosoftLicensing(d *schema.ResourceData) model.MsftLicensingConfig {
+	mssqlLicensing := strings.ToUpper(d.Get("mssql_licensing").(string))
+	windowsLicensing := strings.ToUpper(d.Get("windows_licensing").(string))
+	academicLicense := d.Get("academic_license").(bool)
+	return model.MsftLicensingConfig{
+		MssqlLicensing:   &mssqlLicensing,
+		WindowsLicensing: &windowsLicensing,
+		AcademicLicense:  &academicLicense,
+	}
+}
+
+// setMicrosoftLicensing reads back the licensing configuration of the cluster.
+// A cluster without licensing configuration has both licenses disabled.
+func setMicrosoftLicensing(d *schema.ResourceData, msftLicenseConfig *model.MsftLicensingConfig) error {
+	mssqlLicensing := constants.CapitalLicenseConfigDisabled
+	windowsLicensing := constants.CapitalLicenseConfigDisabled
+	academicLicense := false
+	if msftLicenseConfig != nil {
+		if msftLicenseConfig.MssqlLicensing != nil {
+			mssqlLicensing = *msftLicenseConfig.MssqlLicensing
+		}
+		if msftLicenseConfig.WindowsLicensing != nil {
+			windowsLicensing = *msftLicenseConfig.WindowsLicensing
+		}
+		if msftLicenseConfig.AcademicLicense != nil {
+			academicLicense = *msftLicenseConfig.AcademicLicense
+		}
+	}
+	if err := d.Set("mssql_licensing", mssqlLicensing); err != nil {
+		return err
+	}
+	if err := d.Set("windows_licensing", windowsLicensing); err != nil {
+		return err
+	}
+	return d.Set("academic_license", academicLicense)
+}
+
+// publishMicrosoftLicensing publishes the licensing configuration of the cluster
+// and waits for the task to finish.
+func publishMicrosoftLicensing(d *schema.ResourceData, m interface{}, msftLicenseConfig model.MsftLicensingConfig, timeout time.Duration) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcID := d.Get("sddc_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	var unlockFunction = clusterMutationKeyedMutex.Lock(sddcID)
+	publishClient := msft_licensing.NewPublishClient(connectorWrapper)
+	microsoftLicensingUpdateTask, err := publishClient.Post(orgID, sddcID, clusterID, msftLicenseConfig)
+	if err != nil {
+		unlockFunction()
+		return err
+	}
+	return retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		return task.RetryTaskUntilFinished(connectorWrapper,
+			func() (model.Task, error) {
+				return task.GetTask(connectorWrapper, microsoftLicensingUpdateTask.Id)
+			},
+			"error updating Microsoft licensing configuration "+clusterID,
+			func(_ model.Task) {
+				unlockFunction()
+			})
+	})
+}
@@ -0,0 +1,93 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccResourceVmcMicrosoftLicensingZerocloud(t *testing.T) {
+	resourceName := "vmc_microsoft_licensing.licensing_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcMicrosoftLicensingConfig(constants.CapitalLicenseConfigEnabled, constants.CapitalLicenseConfigDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mssql_licensing", constants.CapitalLicenseConfigEnabled),
+					resource.TestCheckResourceAttr(resourceName, "windows_licensing", constants.CapitalLicenseConfigDisabled),
+				),
+			},
+			{
+				Config: testAccVmcMicrosoftLicensingConfig(constants.LicenseConfigDisabled, constants.LicenseConfigEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mssql_licensing", constants.CapitalLicenseConfigDisabled),
+					resource.TestCheckResourceAttr(resourceName, "windows_licensing", constants.CapitalLicenseConfigEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccVmcClusterResourceImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVmcMicrosoftLicensingConfig(mssqlLicensing string, windowsLicensing string) string {
+	return fmt.Sprintf(`
+data "vmc_sddc" "sddc_1" {
+  sddc_id = %q
+}
+
+resource "vmc_microsoft_licensing" "licensing_1" {
+  sddc_id           = data.vmc_sddc.sddc_1.id
+  cluster_id        = data.vmc_sddc.sddc_1.clusters[0].cluster_id
+  mssql_licensing   = %q
+  windows_licensing = %q
+}
+`, os.Getenv(constants.TestSddcID), mssqlLicensing, windowsLicensing)
+}
+
+func TestSetMicrosoftLicensing(t *testing.T) {
+	enabled := constants.CapitalLicenseConfigEnabled
+	academicLicense := true
+	testResourceSchema := schema.TestResourceDataRaw(t, microsoftLicensingSchema(), map[string]interface{}{})
+
+	assert.NoError(t, setMicrosoftLicensing(testResourceSchema, &model.MsftLicensingConfig{
+		MssqlLicensing:  &enabled,
+		AcademicLicense: &academicLicense,
+	}))
+	assert.Equal(t, enabled, testResourceSchema.Get("mssql_licensing"))
+	assert.Equal(t, constants.CapitalLicenseConfigDisabled, testResourceSchema.Get("windows_licensing"))
+	assert.Equal(t, true, testResourceSchema.Get("academic_license"))
+
+	// licensing toggled off in the console
+	assert.NoError(t, setMicrosoftLicensing(testResourceSchema, nil))
+	assert.Equal(t, constants.CapitalLicenseConfigDisabled, testResourceSchema.Get("mssql_licensing"))
+	assert.Equal(t, false, testResourceSchema.Get("academic_license"))
+}
+
+func TestExpandMicrosoftLicensing(t *testing.T) {
+	testResourceSchema := schema.TestResourceDataRaw(t, microsoftLicensingSchema(), map[string]interface{}{
+		"mssql_licensing":   constants.LicenseConfigEnabled,
+		"windows_licensing": constants.CapitalLicenseConfigDisabled,
+	})
+	got := expandMicrosoftLicensing(testResourceSchema)
+	assert.Equal(t, constants.CapitalLicenseConfigEnabled, *got.MssqlLicensing)
+	assert.Equal(t, constants.CapitalLicenseConfigDisabled, *got.WindowsLicensing)
+	assert.Equal(t, false, *got.AcademicLicense)
+}
@@ -5,36 +5,51 @@
 package vmc
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/model"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
 )
 
 func resourcePublicIP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePublicIPCreate,
-		Read:   resourcePublicIPRead,
-		Update: resourcePublicIPUpdate,
-		Delete: resourcePublicIPDelete,
+		CreateContext: resourcePublicIPCreate,
+		Read:          resourcePublicIPRead,
+		Update:        resourcePublicIPUpdate,
+		Delete:        resourcePublicIPDelete,
+		CustomizeDiff: publicIPCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), ",")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected public_ip_id,nsxt_reverse_proxy_url", d.Id())
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected public_ip_id,sddc_id or public_ip_id,nsxt_reverse_proxy_url", d.Id())
 				}
 				if err := IsValidUUID(idParts[0]); err != nil {
 					return nil, fmt.Errorf("invalid format for public_ip_id : %v", err)
 				}
+				d.SetId(idParts[0])
+				if err := d.Set("adopt_existing", false); err != nil {
+					return nil, err
+				}
+				if IsValidUUID(idParts[1]) == nil {
+					if err := d.Set("sddc_id", idParts[1]); err != nil {
+						return nil, err
+					}
+					return []*schema.ResourceData{d}, nil
+				}
 				if err := IsValidURL(idParts[1]); err != nil {
 					return nil, fmt.Errorf("invalid format for nsxt_reverse_proxy_url : %v", err)
 				}
-				d.SetId(idParts[0])
 				if err := d.Set("nsxt_reverse_proxy_url", idParts[1]); err != nil {
 					return nil, err
 				}
@@ -42,9 +57,17 @@ func resourcePublicIP() *schema.Resource {
 			},
 		},
 		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"sddc_id", "nsxt_reverse_proxy_url"},
+				ValidateFunc: validation.IsUUID,
+				Description:  "SDDC identifier, used to look up the NSX API public endpoint url",
+			},
 			"nsxt_reverse_proxy_url": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "NSX API public endpoint url used for public IP resource management",
 			},
 			"ip": {
@@ -58,24 +81,54 @@ func resourcePublicIP() *schema.Resource {
 				ForceNew:    true,
 				Description: "Display name/notes about this resource",
 			},
+			"adopt_existing": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"display_name"},
+				Description:  "Adopt the existing public IP with the same display name on creation, instead of allocating a new one",
+			},
 		},
 	}
 }
 
-func resourcePublicIPCreate(d *schema.ResourceData, m interface{}) error {
-	nsxtReverseProxyURL := d.Get("nsxt_reverse_proxy_url").(string)
+func resourcePublicIPCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connectorWrapper := m.(*connector.Wrapper)
-	connector, err := getNsxtReverseProxyURLConnector(nsxtReverseProxyURL, connectorWrapper)
+	connector, err := getNsxtConnector(d, connectorWrapper)
 	if err != nil {
-		return HandleCreateError("NSXT reverse proxy URL connector", err)
+		return diag.FromErr(HandleCreateError("NSXT reverse proxy URL connector", err))
 	}
 	publicIpsClient := infra.NewPublicIpsClient(connector)
 
 	displayName := d.Get("display_name").(string)
+	if d.Get("adopt_existing").(bool) {
+		publicIpsList, err := listPublicIPs(publicIpsClient)
+		if err != nil {
+			return diag.FromErr(HandleListError("Public IP", err))
+		}
+		existingPublicIP, err := findPublicIPByDisplayName(publicIpsList, displayName)
+		if err != nil {
+			return diag.FromErr(HandleCreateError("Public IP", err))
+		}
+		if existingPublicIP != nil {
+			publicIPID := stringValue(existingPublicIP.Id)
+			log.Printf("[INFO] Adopting public IP %s with display name %q", publicIPID, displayName)
+			d.SetId(publicIPID)
+			if err := resourcePublicIPRead(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Adopted existing public IP %s with display name %q", publicIPID, displayName),
+				Detail: "The public IP is released when this resource is destroyed. Make sure it is not managed " +
+					"by another vmc_public_ip resource or Terraform state, destroying either one releases it for both.",
+			}}
+		}
+	}
 	// generate random UUID
 	UUIDObject, err := uuid.NewV4()
 	if err != nil {
-		return HandleCreateError("Public IP", err)
+		return diag.FromErr(HandleCreateError("Public IP", err))
 	}
 	UUIDStr := UUIDObject.String()
 
@@ -88,23 +141,31 @@ func resourcePublicIPCreate(d *schema.ResourceData, m interface{}) error {
 	// API call to create public IP
 	publicIP, err := publicIpsClient.Update(UUIDStr, *publicIPModel)
 	if err != nil {
-		return HandleCreateError("Public IP", err)
+		return diag.FromErr(HandleCreateError("Public IP", err))
 	}
 
 	d.SetId(*publicIP.Id)
-	return resourcePublicIPRead(d, m)
+	return diag.FromErr(resourcePublicIPRead(d, m))
 }
 
 func resourcePublicIPRead(d *schema.ResourceData, m interface{}) error {
-	nsxtReverseProxyURL := d.Get("nsxt_reverse_proxy_url").(string)
 	connectorWrapper := m.(*connector.Wrapper)
-	connector, err := getNsxtReverseProxyURLConnector(nsxtReverseProxyURL, connectorWrapper)
+	connector, err := getNsxtConnector(d, connectorWrapper)
 	if err != nil {
 		return HandleCreateError("NSXT reverse proxy URL connector", err)
 	}
 	publicIpsClient := infra.NewPublicIpsClient(connector)
 	uuid := d.Id()
 
+	if sddcID := d.Get("sddc_id").(string); sddcID != "" {
+		nsxtReverseProxyURL, err := getSddcNsxtReverseProxyURL(sddcID, connectorWrapper)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("nsxt_reverse_proxy_url", nsxtReverseProxyURL); err != nil {
+			return err
+		}
+	}
 	if len(uuid) > 0 {
 		publicIP, err := publicIpsClient.Get(uuid)
 		if err != nil {
@@ -120,20 +181,21 @@ func resourcePublicIPRead(d *schema.ResourceData, m interface{}) error {
 		displayName := d.Get("display_name").(string)
 		if len(displayName) > 0 {
 			// get the list of IPs
-			publicIPResultList, err := publicIpsClient.List(nil, nil, nil, nil, nil)
+			publicIpsList, err := listPublicIPs(publicIpsClient)
 			if err != nil {
 				return HandleListError("Public IP", err)
 			}
-			publicIpsList := publicIPResultList.Results
-			for _, publicIP := range publicIpsList {
-				if displayName == *publicIP.DisplayName {
-					if err := d.Set("ip", publicIP.Ip); err != nil {
-						return err
-					}
-					if err := d.Set("display_name", publicIP.DisplayName); err != nil {
-						return err
-					}
-					break
+			publicIP, err := findPublicIPByDisplayName(publicIpsList, displayName)
+			if err != nil {
+				return err
+			}
+			if publicIP != nil {
+				d.SetId(stringValue(publicIP.Id))
+				if err := d.Set("ip", publicIP.Ip); err != nil {
+					return err
+				}
+				if err := d.Set("display_name", publicIP.DisplayName); err != nil {
+					return err
 				}
 			}
 		}
@@ -142,9 +204,8 @@ func resourcePublicIPRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePublicIPUpdate(d *schema.ResourceData, m interface{}) error {
-	nsxtReverseProxyURL := d.Get("nsxt_reverse_proxy_url").(string)
 	connectorWrapper := m.(*connector.Wrapper)
-	connector, err := getNsxtReverseProxyURLConnector(nsxtReverseProxyURL, connectorWrapper)
+	connector, err := getNsxtConnector(d, connectorWrapper)
 	if err != nil {
 		return HandleCreateError("NSXT reverse proxy URL connector", err)
 	}
@@ -175,9 +236,8 @@ func resourcePublicIPUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePublicIPDelete(d *schema.ResourceData, m interface{}) error {
-	nsxtReverseProxyURL := d.Get("nsxt_reverse_proxy_url").(string)
 	connectorWrapper := m.(*connector.Wrapper)
-	connector, err := getNsxtReverseProxyURLConnector(nsxtReverseProxyURL, connectorWrapper)
+	connector, err := getNsxtConnector(d, connectorWrapper)
 	if err != nil {
 		return HandleCreateError("NSXT reverse proxy URL connector", err)
 	}
@@ -191,3 +251,80 @@ func resourcePublicIPDelete(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// publicIPCustomizeDiff replaces the public IP when a change of sddc_id points
+// it to another NSX instance, as the public IP belongs to the NSX instance it
+// was allocated in. Switching between sddc_id and nsxt_reverse_proxy_url of
+// the same NSX instance keeps the public IP.
+func publicIPCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("sddc_id") {
+		return nil
+	}
+	if !d.NewValueKnown("sddc_id") || !d.NewValueKnown("nsxt_reverse_proxy_url") {
+		return d.ForceNew("sddc_id")
+	}
+	oldNsxtReverseProxyURL, nsxtReverseProxyURL := d.GetChange("nsxt_reverse_proxy_url")
+	newNsxtReverseProxyURL := nsxtReverseProxyURL.(string)
+	if sddcID := d.Get("sddc_id").(string); sddcID != "" {
+		connectorWrapper, ok := m.(*connector.Wrapper)
+		if !ok {
+			return d.ForceNew("sddc_id")
+		}
+		var err error
+		newNsxtReverseProxyURL, err = getSddcNsxtReverseProxyURL(sddcID, connectorWrapper)
+		if err != nil {
+			return err
+		}
+	}
+	if !sameNsxtReverseProxyURL(oldNsxtReverseProxyURL.(string), newNsxtReverseProxyURL) {
+		return d.ForceNew("sddc_id")
+	}
+	return nil
+}
+
+// sameNsxtReverseProxyURL reports whether the reverse proxy URLs point to the
+// same NSX instance, with or without the NSX manager path.
+func sameNsxtReverseProxyURL(a string, b string) bool {
+	return strings.TrimSuffix(strings.ReplaceAll(a, constants.SksNSXTManager, ""), "/") ==
+		strings.TrimSuffix(strings.ReplaceAll(b, constants.SksNSXTManager, ""), "/")
+}
+
+// findPublicIPByDisplayName returns the public IP with the display name, nil if
+// there is none. The display name must be unique.
+func findPublicIPByDisplayName(publicIPs []model.PublicIp, displayName string) (*model.PublicIp, error) {
+	var matchingPublicIPs []model.PublicIp
+	var ids []string
+	for _, publicIP := range publicIPs {
+		if stringValue(publicIP.DisplayName) == displayName {
+			matchingPublicIPs = append(matchingPublicIPs, publicIP)
+			ids = append(ids, stringValue(publicIP.Id))
+		}
+	}
+	switch len(matchingPublicIPs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matchingPublicIPs[0], nil
+	default:
+		return nil, fmt.Errorf("%d public IPs with display name %q found (%s), cannot choose which one to adopt",
+			len(matchingPublicIPs), displayName, strings.Join(ids, ", "))
+	}
+}
+
+// listPublicIPs returns all the public IPs of the SDDC, following the pages of
+// the results.
+func listPublicIPs(publicIpsClient infra.PublicIpsClient) ([]model.PublicIp, error) {
+	var publicIPs []model.PublicIp
+	var cursor *string
+	for {
+		result, err := publicIpsClient.List(cursor, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		publicIPs = append(publicIPs, result.Results...)
+		if result.Cursor == nil || *result.Cursor == "" || len(result.Results) == 0 {
+			return publicIPs, nil
+		}
+		cursor = result.Cursor
+	}
+}
@@ -0,0 +1,338 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+)
+
+// publicIPPoolConcurrency the maximum number of public IPs allocated or released
+// at the same time.
+const publicIPPoolConcurrency = 5
+
+func resourcePublicIPPool() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourcePublicIPPoolCreate,
+		Read:          resourcePublicIPPoolRead,
+		Update:        resourcePublicIPPoolUpdate,
+		Delete:        resourcePublicIPPoolDelete,
+		CustomizeDiff: publicIPPoolCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"sddc_id", "nsxt_reverse_proxy_url"},
+				ValidateFunc: validation.IsUUID,
+				Description:  "SDDC identifier, used to look up the NSX API public endpoint url",
+			},
+			"nsxt_reverse_proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "NSX API public endpoint url used for public IP resource management",
+			},
+			"names": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"names", "ip_count"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Display names of the public IPs to allocate, one public IP per name",
+			},
+			"ip_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of public IPs to allocate, named name_prefix-1 to name_prefix-<ip_count>",
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public-ip",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Prefix of the display names of the public IPs allocated with ip_count",
+			},
+			"ips": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Public IPs, by display name",
+			},
+			"ip_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Public IP identifiers, by display name",
+			},
+		},
+	}
+}
+
+func resourcePublicIPPoolCreate(d *schema.ResourceData, m interface{}) error {
+	poolID, err := uuid.NewV4()
+	if err != nil {
+		return HandleCreateError("Public IP pool", err)
+	}
+	d.SetId(poolID.String())
+	if err := reconcilePublicIPPool(d, m); err != nil {
+		return HandleCreateError("Public IP pool", err)
+	}
+	return resourcePublicIPPoolRead(d, m)
+}
+
+func resourcePublicIPPoolRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	nsxConnector, err := getNsxtConnector(d, connectorWrapper)
+	if err != nil {
+		return HandleCreateError("NSXT reverse proxy URL connector", err)
+	}
+	if sddcID := d.Get("sddc_id").(string); sddcID != "" {
+		nsxtReverseProxyURL, err := getSddcNsxtReverseProxyURL(sddcID, connectorWrapper)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("nsxt_reverse_proxy_url", nsxtReverseProxyURL); err != nil {
+			return err
+		}
+	}
+	publicIPs, err := listPublicIPs(infra.NewPublicIpsClient(nsxConnector))
+	if err != nil {
+		return HandleListError("Public IP", err)
+	}
+	publicIPsByID := map[string]model.PublicIp{}
+	for _, publicIP := range publicIPs {
+		publicIPsByID[stringValue(publicIP.Id)] = publicIP
+	}
+
+	// Public IPs released outside of Terraform are dropped, to be allocated again
+	ips := map[string]string{}
+	ipIDs := map[string]string{}
+	for name, id := range d.Get("ip_ids").(map[string]interface{}) {
+		if publicIP, ok := publicIPsByID[id.(string)]; ok {
+			ips[name] = stringValue(publicIP.Ip)
+			ipIDs[name] = id.(string)
+		}
+	}
+	if err := d.Set("ips", ips); err != nil {
+		return err
+	}
+	return d.Set("ip_ids", ipIDs)
+}
+
+func resourcePublicIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := reconcilePublicIPPool(d, m); err != nil {
+		return HandleUpdateError("Public IP pool", err)
+	}
+	return resourcePublicIPPoolRead(d, m)
+}
+
+func resourcePublicIPPoolDelete(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	nsxConnector, err := getNsxtConnector(d, connectorWrapper)
+	if err != nil {
+		return HandleCreateError("NSXT reverse proxy URL connector", err)
+	}
+	publicIpsClient := infra.NewPublicIpsClient(nsxConnector)
+	allIPIDs := toStringMap(d.Get("ip_ids").(map[string]interface{}))
+	ipIDs := toStringMap(d.Get("ip_ids").(map[string]interface{}))
+
+	var mutex sync.Mutex
+	err = forEachConcurrently(sortedKeys(allIPIDs), publicIPPoolConcurrency, func(name string) error {
+		if err := releasePublicIP(publicIpsClient, allIPIDs[name]); err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(ipIDs, name)
+		return nil
+	})
+	if err != nil {
+		// Keep the public IPs that could not be released in the state
+		if setErr := d.Set("ip_ids", ipIDs); setErr != nil {
+			return setErr
+		}
+		return HandleDeleteError("Public IP pool", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// publicIPPoolCustomizeDiff marks the public IPs as known after apply when public
+// IPs are added to or removed from the pool, or were released outside of
+// Terraform, so that the pool is reconciled.
+func publicIPPoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.NewValueKnown("names") && d.NewValueKnown("ip_count") && d.NewValueKnown("name_prefix") &&
+		!publicIPPoolNeedsReconcile(d.Get("ip_ids").(map[string]interface{}),
+			publicIPPoolNames(expandStringSet(d.Get("names").(*schema.Set)), d.Get("ip_count").(int), d.Get("name_prefix").(string))) {
+		return nil
+	}
+	if err := d.SetNewComputed("ips"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("ip_ids")
+}
+
+// publicIPPoolNeedsReconcile true if the public IPs in the state, by name, do
+// not match the desired names.
+func publicIPPoolNeedsReconcile(ipIDs map[string]interface{}, desired []string) bool {
+	toAllocate, toRelease := diffPublicIPPool(toStringMap(ipIDs), desired)
+	return len(toAllocate) > 0 || len(toRelease) > 0
+}
+
+// reconcilePublicIPPool allocates the public IPs missing from the pool and
+// releases the ones no longer wanted, leaving the others untouched. The public
+// IPs allocated or released are recorded in the state, even on error.
+func reconcilePublicIPPool(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	nsxConnector, err := getNsxtConnector(d, connectorWrapper)
+	if err != nil {
+		return err
+	}
+	publicIpsClient := infra.NewPublicIpsClient(nsxConnector)
+
+	// The planned public IPs are unknown when the pool changes, start from the
+	// public IPs in the state
+	currentIPIDs, _ := d.GetChange("ip_ids")
+	ipIDs := toStringMap(currentIPIDs.(map[string]interface{}))
+	toAllocate, toRelease := diffPublicIPPool(ipIDs,
+		publicIPPoolNames(expandStringSet(d.Get("names").(*schema.Set)), d.Get("ip_count").(int), d.Get("name_prefix").(string)))
+
+	var mutex sync.Mutex
+	allocateErr := forEachConcurrently(toAllocate, publicIPPoolConcurrency, func(name string) error {
+		id, err := allocatePublicIP(publicIpsClient, name)
+		if err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		ipIDs[name] = id
+		return nil
+	})
+	releaseErr := forEachConcurrently(toRelease, publicIPPoolConcurrency, func(name string) error {
+		mutex.Lock()
+		id := ipIDs[name]
+		mutex.Unlock()
+		if err := releasePublicIP(publicIpsClient, id); err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(ipIDs, name)
+		return nil
+	})
+	if err := d.Set("ip_ids", ipIDs); err != nil {
+		return err
+	}
+	return errors.Join(allocateErr, releaseErr)
+}
+
+func allocatePublicIP(publicIpsClient infra.PublicIpsClient, name string) (string, error) {
+	UUIDObject, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	id := UUIDObject.String()
+	publicIP, err := publicIpsClient.Update(id, model.PublicIp{
+		DisplayName: &name,
+		Id:          &id,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error allocating public IP %s: %w", name, err)
+	}
+	return stringValue(publicIP.Id), nil
+}
+
+func releasePublicIP(publicIpsClient infra.PublicIpsClient, id string) error {
+	forceDelete := true
+	if err := publicIpsClient.Delete(id, &forceDelete); err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error releasing public IP %s: %w", id, err)
+	}
+	return nil
+}
+
+// publicIPPoolNames the display names of the public IPs of the pool, the names
+// if set, or else ipCount names made of the prefix and a sequence number.
+func publicIPPoolNames(names []string, ipCount int, namePrefix string) []string {
+	if len(names) > 0 {
+		return names
+	}
+	poolNames := make([]string, 0, ipCount)
+	for i := 1; i <= ipCount; i++ {
+		poolNames = append(poolNames, fmt.Sprintf("%s-%d", namePrefix, i))
+	}
+	return poolNames
+}
+
+// diffPublicIPPool returns the sorted names of the public IPs to allocate and to
+// release to go from the current public IPs, by name, to the desired names.
+func diffPublicIPPool(current map[string]string, desired []string) (toAllocate []string, toRelease []string) {
+	desiredNames := map[string]bool{}
+	for _, name := range desired {
+		desiredNames[name] = true
+		if _, ok := current[name]; !ok {
+			toAllocate = append(toAllocate, name)
+		}
+	}
+	for name := range current {
+		if !desiredNames[name] {
+			toRelease = append(toRelease, name)
+		}
+	}
+	sort.Strings(toAllocate)
+	sort.Strings(toRelease)
+	return toAllocate, toRelease
+}
+
+// forEachConcurrently calls fn for each of the items, running at most limit calls
+// at the same time, and returns the errors of all the calls joined.
+func forEachConcurrently(items []string, limit int, fn func(item string) error) error {
+	semaphore := make(chan struct{}, limit)
+	errs := make([]error, len(items))
+	var waitGroup sync.WaitGroup
+	for i, item := range items {
+		waitGroup.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+			errs[i] = fn(item)
+		}()
+	}
+	waitGroup.Wait()
+	return errors.Join(errs...)
+}
+
+func toStringMap(values map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = value.(string)
+	}
+	return result
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,106 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccResourceVmcPublicIPPoolBasic(t *testing.T) {
+	resourceName := "vmc_public_ip_pool.pool_1"
+	namePrefix := "terraform-test-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcPublicIPPoolConfig(namePrefix, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ips.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "ips."+namePrefix+"-3"),
+				),
+			},
+			{
+				Config: testAccVmcPublicIPPoolConfig(namePrefix, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ips.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "ips."+namePrefix+"-3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVmcPublicIPPoolConfig(namePrefix string, ipCount int) string {
+	return fmt.Sprintf(`
+resource "vmc_public_ip_pool" "pool_1" {
+  sddc_id     = %q
+  name_prefix = %q
+  ip_count    = %d
+}
+`, os.Getenv(constants.TestSddcID), namePrefix, ipCount,
+	)
+}
+
+func TestPublicIPPoolNames(t *testing.T) {
+	assert.Equal(t, []string{"web", "mail"}, publicIPPoolNames([]string{"web", "mail"}, 0, "public-ip"))
+	assert.Equal(t, []string{"public-ip-1", "public-ip-2", "public-ip-3"}, publicIPPoolNames(nil, 3, "public-ip"))
+	assert.Empty(t, publicIPPoolNames(nil, 0, "public-ip"))
+}
+
+func TestDiffPublicIPPool(t *testing.T) {
+	current := map[string]string{"web": "id-1", "mail": "id-2", "vpn": "id-3"}
+
+	toAllocate, toRelease := diffPublicIPPool(current, []string{"web", "vpn", "ftp", "dns"})
+	assert.Equal(t, []string{"dns", "ftp"}, toAllocate)
+	assert.Equal(t, []string{"mail"}, toRelease)
+
+	toAllocate, toRelease = diffPublicIPPool(current, []string{"mail", "vpn", "web"})
+	assert.Empty(t, toAllocate)
+	assert.Empty(t, toRelease)
+}
+
+func TestPublicIPPoolNeedsReconcile(t *testing.T) {
+	ipIDs := map[string]interface{}{"public-ip-1": "id-1", "public-ip-2": "id-2"}
+	assert.False(t, publicIPPoolNeedsReconcile(ipIDs, publicIPPoolNames(nil, 2, "public-ip")))
+	assert.True(t, publicIPPoolNeedsReconcile(ipIDs, publicIPPoolNames(nil, 3, "public-ip")))
+	assert.True(t, publicIPPoolNeedsReconcile(ipIDs, publicIPPoolNames(nil, 2, "ip")))
+
+	// public-ip-2 was released outside of Terraform and dropped on refresh
+	delete(ipIDs, "public-ip-2")
+	assert.True(t, publicIPPoolNeedsReconcile(ipIDs, publicIPPoolNames(nil, 2, "public-ip")))
+	assert.True(t, publicIPPoolNeedsReconcile(map[string]interface{}{}, []string{"web"}))
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	var running, maxRunning, calls int32
+	err := forEachConcurrently([]string{"a", "b", "c", "d", "e", "f", "g"}, 3, func(item string) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+		atomic.AddInt32(&calls, 1)
+		if item == "c" || item == "f" {
+			return fmt.Errorf("error %s", item)
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "error c\nerror f")
+	assert.Equal(t, int32(7), calls)
+	assert.LessOrEqual(t, maxRunning, int32(3))
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-vmc-aws-integration/nsx_vmc_app/model"
 
 	"github.com/vmware/terraform-provider-vmc/vmc/connector"
 	"github.com/vmware/terraform-provider-vmc/vmc/constants"
@@ -44,6 +46,105 @@ func TestAccResourceVmcPublicIp_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceVmcPublicIpSddcID(t *testing.T) {
+	displayName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "vmc_public_ip.public_ip_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVmcPublicIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcPublicIPConfigSddcID(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVmcPublicIPExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "nsxt_reverse_proxy_url", os.Getenv(constants.NsxtReverseProxyURL)),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s,%s", rs.Primary.ID, rs.Primary.Attributes["sddc_id"]), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceVmcPublicIpAdoptExisting(t *testing.T) {
+	displayName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "vmc_public_ip.adopted"
+	var adoptedID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVmcPublicIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Configures the provider used to allocate the public IP out of band
+				Config: testAccVmcPublicIPsDataSourceConfig(),
+			},
+			{
+				PreConfig: func() {
+					connectorWrapper := testAccProvider.Meta().(*connector.Wrapper)
+					nsxConnector, err := getNsxtReverseProxyURLConnector(os.Getenv(constants.NsxtReverseProxyURL), connectorWrapper)
+					if err != nil {
+						t.Fatalf("error creating client nsxConnector : %v ", err)
+					}
+					adoptedID, err = allocatePublicIP(infra.NewPublicIpsClient(nsxConnector), displayName)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccVmcPublicIPConfigAdoptExisting(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVmcPublicIPExists(resourceName),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &adoptedID),
+					resource.TestCheckResourceAttr(resourceName, "display_name", displayName),
+				),
+			},
+		},
+	})
+}
+
+func TestFindPublicIPByDisplayName(t *testing.T) {
+	publicIP := func(id string, displayName string) model.PublicIp {
+		return model.PublicIp{Id: &id, DisplayName: &displayName}
+	}
+	publicIPs := []model.PublicIp{
+		publicIP("ip-1", "web"),
+		publicIP("ip-2", "db"),
+		publicIP("ip-3", "db"),
+	}
+
+	found, err := findPublicIPByDisplayName(publicIPs, "web")
+	assert.NoError(t, err)
+	assert.Equal(t, "ip-1", *found.Id)
+
+	found, err = findPublicIPByDisplayName(publicIPs, "mail")
+	assert.NoError(t, err)
+	assert.Nil(t, found)
+
+	found, err = findPublicIPByDisplayName(publicIPs, "db")
+	assert.ErrorContains(t, err, "2 public IPs with display name \"db\" found (ip-2, ip-3)")
+	assert.Nil(t, found)
+}
+
+func TestSameNsxtReverseProxyURL(t *testing.T) {
+	nsxtReverseProxyURL := "https://nsx-44-228-76-55.rp.vmwarevmc.com/vmc/reverse-proxy/api/orgs/org-1/sddcs/sddc-1"
+	assert.True(t, sameNsxtReverseProxyURL(nsxtReverseProxyURL, nsxtReverseProxyURL))
+	assert.True(t, sameNsxtReverseProxyURL(nsxtReverseProxyURL+"/sks-nsxt-manager", nsxtReverseProxyURL))
+	assert.True(t, sameNsxtReverseProxyURL(nsxtReverseProxyURL+"/", nsxtReverseProxyURL))
+	assert.False(t, sameNsxtReverseProxyURL(nsxtReverseProxyURL, "https://nsx-44-228-76-56.rp.vmwarevmc.com/vmc/reverse-proxy/api/orgs/org-1/sddcs/sddc-2"))
+	assert.False(t, sameNsxtReverseProxyURL("", nsxtReverseProxyURL))
+}
+
 func testAccCheckVmcPublicIPExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -116,6 +217,39 @@ resource "vmc_public_ip" "public_ip_1" {
 	)
 }
 
+func testAccVmcPublicIPConfigSddcID(displayName string) string {
+	return fmt.Sprintf(`
+resource "vmc_public_ip" "public_ip_1" {
+  display_name = %q
+  sddc_id      = %q
+}
+`, displayName,
+		os.Getenv(constants.TestSddcID),
+	)
+}
+
+func testAccVmcPublicIPsDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "vmc_public_ips" "all" {
+  sddc_id = %q
+}
+`,
+		os.Getenv(constants.TestSddcID),
+	)
+}
+
+func testAccVmcPublicIPConfigAdoptExisting(displayName string) string {
+	return fmt.Sprintf(`
+resource "vmc_public_ip" "adopted" {
+  display_name   = %q
+  sddc_id        = %q
+  adopt_existing = true
+}
+`, displayName,
+		os.Getenv(constants.TestSddcID),
+	)
+}
+
 func testAccVmcPublicIPResourceImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -125,3 +259,47 @@ func testAccVmcPublicIPResourceImportStateIDFunc(resourceName string) resource.I
 		return fmt.Sprintf("%s,%s", rs.Primary.ID, rs.Primary.Attributes["nsxt_reverse_proxy_url"]), nil
 	}
 }
+
+// publicIpsClientStub returns the pages of public IPs, the cursor of each page
+// being the index of the next page.
+type publicIpsClientStub struct {
+	infra.PublicIpsClient
+	pages   [][]model.PublicIp
+	cursors []*string
+}
+
+func (stub *publicIpsClientStub) List(cursorParam *string, _ *string, _ *int64, _ *bool, _ *string) (model.PublicIpsListResult, error) {
+	stub.cursors = append(stub.cursors, cursorParam)
+	page := 0
+	if cursorParam != nil {
+		if _, err := fmt.Sscan(*cursorParam, &page); err != nil {
+			return model.PublicIpsListResult{}, err
+		}
+	}
+	result := model.PublicIpsListResult{Results: stub.pages[page]}
+	if page+1 < len(stub.pages) {
+		cursor := fmt.Sprint(page + 1)
+		result.Cursor = &cursor
+	}
+	return result, nil
+}
+
+func TestListPublicIPs(t *testing.T) {
+	newPublicIP := func(id string) model.PublicIp {
+		return model.PublicIp{Id: &id}
+	}
+	stub := &publicIpsClientStub{
+		pages: [][]model.PublicIp{
+			{newPublicIP("ip-1"), newPublicIP("ip-2")},
+			{newPublicIP("ip-3")},
+			{newPublicIP("ip-4")},
+		},
+	}
+	publicIPs, err := listPublicIPs(stub)
+	assert.NoError(t, err)
+	assert.Len(t, publicIPs, 4)
+	assert.Equal(t, "ip-4", *publicIPs[3].Id)
+	assert.Len(t, stub.cursors, 3)
+	assert.Nil(t, stub.cursors[0])
+	assert.Equal(t, "2", *stub.cursors[2])
+}
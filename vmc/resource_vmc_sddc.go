@@ -8,9 +8,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,12 +35,12 @@ import (
 
 func resourceSddc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSddcCreate,
-		Read:   resourceSddcRead,
-		Update: resourceSddcUpdate,
-		Delete: resourceSddcDelete,
+		CreateContext: resourceSddcCreateWithPreflight,
+		Read:          resourceSddcRead,
+		Update:        resourceSddcUpdate,
+		Delete:        resourceSddcDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSddcImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(300 * time.Minute),
@@ -43,9 +48,347 @@ func resourceSddc() *schema.Resource {
 			Delete: schema.DefaultTimeout(180 * time.Minute),
 		},
 		Schema: sddcSchema(),
+		CustomizeDiff: customdiff.All(
+			storageCapacityCustomizeDiff,
+			hostQuotaCustomizeDiff("num_host"),
+		),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSddcV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSddcStateUpgradeV0,
+			},
+		},
 	}
 }
 
+// resourceSddcV0 the SDDC resource before the cluster_info map was replaced by
+// the clusters block.
+func resourceSddcV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: sddcSchemaV0(),
+	}
+}
+
+// sddcSchemaV0 a frozen copy of the attribute types of the version 0 SDDC schema,
+// independent of later changes to sddcSchema.
+func sddcSchemaV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sddc_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"size": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"account_link_sddc_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"customer_subnet_ids": {
+						Type: schema.TypeList,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional: true,
+					},
+					"connected_account_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"vpc_cidr": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"num_host": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"sddc_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vxlan_subnet": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"delay_account_link": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"provider_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"skip_creating_vxlan": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"sso_domain": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"sddc_template_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"deployment_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"cluster_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"host_instance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"edrs_policy_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"enable_edrs": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"min_hosts": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_hosts": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"microsoft_licensing_config": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mssql_licensing": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"windows_licensing": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"academic_license": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+			Optional: true,
+		},
+		"intranet_mtu_uplink": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"sddc_state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vc_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cloud_username": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cloud_password": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"nsxt_reverse_proxy_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cluster_info": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"sddc_size": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"availability_zones": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"nsxt_ui": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"nsxt_cloudadmin": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"nsxt_cloudadmin_password": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"nsxt_cloudaudit": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"nsxt_cloudaudit_password": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"nsxt_private_ip": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"nsxt_private_url": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"updated": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"user_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"updated_by_user_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"updated_by_user_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"user_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"org_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"account_link_state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sddc_access_state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// resourceSddcStateUpgradeV0 moves the primary cluster information from the
+// cluster_info map to the clusters block.
+func resourceSddcStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	rawState["clusters"] = upgradeClusterInfoV0(rawState["cluster_info"], rawState["cluster_id"])
+	return rawState, nil
+}
+
+// resourceSddcImport sets the arguments of the SDDC that resourceSddcRead
+// leaves untouched, so that the configuration the SDDC was created with matches
+// the imported state.
+func resourceSddcImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connectorWrapper := m.(*connector.Wrapper)
+	sddcID := d.Id()
+	orgID := connectorWrapper.OrgID
+	if err := IsValidUUID(sddcID); err != nil {
+		return nil, fmt.Errorf("invalid format for id : %v", err)
+	}
+	sddc, err := GetSddc(connectorWrapper.Connector, orgID, sddcID)
+	if err != nil {
+		return nil, HandleReadError(d, "SDDC", sddcID, err)
+	}
+	primaryClusterClient := sddcs.NewPrimaryclusterClient(connectorWrapper.Connector)
+	primaryCluster, err := primaryClusterClient.Get(orgID, sddcID)
+	if err != nil {
+		return nil, HandleReadError(d, "Primary Cluster", sddcID, err)
+	}
+	if err := setSddcImportedArguments(d, sddc, primaryCluster); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// setSddcImportedArguments sets the arguments that are only known to the VMC API
+// as part of the SDDC creation request, or that have defaults in the schema.
+func setSddcImportedArguments(d *schema.ResourceData, sddc model.Sddc, primaryCluster model.Cluster) error {
+	importedArguments := map[string]interface{}{
+		"delay_account_link":          false,
+		"skip_preflight_validation":   false,
+		"omit_credentials_from_state": false,
+		"intranet_mtu_uplink":         constants.MinIntranetMtuLink,
+		"size":                        constants.MediumSddcSize,
+	}
+	if primaryCluster.EsxHostInfo != nil && primaryCluster.EsxHostInfo.InstanceType != nil {
+		importedArguments["host_instance_type"] = fromHostInstanceType(*primaryCluster.EsxHostInfo.InstanceType)
+	}
+	msftLicenseConfig := primaryCluster.MsftLicenseConfig
+	resourceConfig := sddc.ResourceConfig
+	if resourceConfig != nil {
+		if resourceConfig.SddcSize != nil && resourceConfig.SddcSize.Size != nil {
+			importedArguments["size"] = strings.ToLower(*resourceConfig.SddcSize.Size)
+		}
+		var accountLinkSddcConfig []map[string]interface{}
+		for _, config := range resourceConfig.AccountLinkSddcConfig {
+			accountLinkSddcConfig = append(accountLinkSddcConfig, map[string]interface{}{
+				"customer_subnet_ids":  config.CustomerSubnetIds,
+				"connected_account_id": stringValue(config.ConnectedAccountId),
+			})
+		}
+		importedArguments["account_link_sddc_config"] = accountLinkSddcConfig
+		if msftLicenseConfig == nil {
+			msftLicenseConfig = resourceConfig.MsftLicenseConfig
+		}
+	}
+	if msftLicenseConfig != nil {
+		importedArguments["microsoft_licensing_config"] = []map[string]interface{}{
+			{
+				"mssql_licensing":   stringValue(msftLicenseConfig.MssqlLicensing),
+				"windows_licensing": stringValue(msftLicenseConfig.WindowsLicensing),
+				"academic_license":  msftLicenseConfig.AcademicLicense != nil && *msftLicenseConfig.AcademicLicense,
+			},
+		}
+	}
+	for key, value := range importedArguments {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // sddcSchema this helper function extracts the creation of the SDDC schema, so that
 // it's made available for mocking in tests.
 func sddcSchema() map[string]*schema.Schema {
@@ -61,6 +404,9 @@ func sddcSchema() map[string]*schema.Schema {
 			Default:  constants.MediumSddcSize,
 			ValidateFunc: validation.StringInSlice([]string{
 				constants.MediumSddcSize, constants.CapitalMediumSddcSize, constants.LargeSddcSize, constants.CapitalLargeSddcSize}, false),
+			DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+				return strings.EqualFold(o, n)
+			},
 			Description: "The size of the vCenter and NSX appliances. 'large' or 'LARGE' SDDC size corresponds to a large vCenter appliance and large NSX appliance. 'medium' or 'MEDIUM' SDDC size corresponds to medium vCenter appliance and medium NSX appliance. Default : 'medium'.",
 		},
 		"account_link_sddc_config": {
@@ -165,6 +511,14 @@ func sddcSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice(
 				[]string{constants.HostInstancetypeI3, constants.HostInstancetypeI3EN, constants.HostInstancetypeI4I, constants.HostInstancetypeC6I, constants.HostInstancetypeM7i24xl, constants.HostInstancetypeM7i48xl}, false),
 		},
+		"storage_capacity": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(storageCapacities(), false),
+			Description:  "The storage capacity to provision for the primary cluster of the SDDC, instead of the storage attached to the hosts. Possible values: 15TB, 20TB, 25TB, 30TB, 35TB.",
+		},
 		"edrs_policy_type": {
 			Type: schema.TypeString,
 			// Exact value known after create
@@ -232,6 +586,18 @@ func sddcSchema() map[string]*schema.Schema {
 			Description:  "Uplink MTU of direct connect, SDDC-grouping and outposts traffic in edge tier-0 router port.",
 			ValidateFunc: validation.IntBetween(constants.MinIntranetMtuLink, constants.MaxIntranetMtuLink),
 		},
+		"skip_preflight_validation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Skip validating the SDDC configuration against the organization's provisioning spec and the VMC validation API before the SDDC is created.",
+		},
+		"omit_credentials_from_state": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Do not store the vCenter and NSX passwords of the SDDC in the Terraform state.",
+		},
 		"sddc_state": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -245,16 +611,21 @@ func sddcSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"cloud_password": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
 		},
 		"nsxt_reverse_proxy_url": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		"cluster_info": {
-			Type:     schema.TypeMap,
-			Computed: true,
+		"remove_host_ids": removeHostIDsSchema(),
+		"cluster_info":    clusterInfoV0Schema("clusters"),
+		"clusters": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        clusterInfoSchema(),
+			Description: "Information about the clusters of the SDDC, such as name, state, ESX hosts, capacity and licensing.",
 		},
 		"sddc_size": {
 			Type:     schema.TypeMap,
@@ -276,9 +647,11 @@ func sddcSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"nsxt_cloudadmin_password": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:       schema.TypeString,
+			Optional:   true,
+			Computed:   true,
+			Sensitive:  true,
+			Deprecated: "nsxt_cloudadmin_password is set by the VMC API, setting it has no effect and will not be allowed in the next major release",
 		},
 		"nsxt_cloudaudit": {
 			Type:     schema.TypeString,
@@ -286,9 +659,11 @@ func sddcSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"nsxt_cloudaudit_password": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:       schema.TypeString,
+			Optional:   true,
+			Computed:   true,
+			Sensitive:  true,
+			Deprecated: "nsxt_cloudaudit_password is set by the VMC API, setting it has no effect and will not be allowed in the next major release",
 		},
 		"nsxt_private_ip": {
 			Type:     schema.TypeString,
@@ -345,6 +720,24 @@ func sddcSchema() map[string]*schema.Schema {
 	}
 }
 
+// resourceSddcCreateWithPreflight validates the SDDC configuration before sending
+// it for provisioning, so that problems like a bad subnet, CIDR or region are
+// reported all at once instead of failing the SDDC creation task.
+func resourceSddcCreateWithPreflight(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !d.Get("skip_preflight_validation").(bool) {
+		awsSddcConfig, err := buildAwsSddcConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags = validateSddcConfig(m.(*connector.Wrapper), awsSddcConfig)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return append(diags, diag.FromErr(resourceSddcCreate(d, m))...)
+}
+
 func resourceSddcCreate(d *schema.ResourceData, m interface{}) error {
 	connectorWrapper := m.(*connector.Wrapper)
 	sddcClient := orgs.NewSddcsClient(connectorWrapper)
@@ -455,31 +848,21 @@ func resourceSddcRead(d *schema.ResourceData, m interface{}) error {
 	if err := d.Set("cluster_id", primaryCluster.ClusterId); err != nil {
 		return err
 	}
-	cluster := map[string]string{}
-	cluster["cluster_name"] = *primaryCluster.ClusterName
-	cluster["cluster_state"] = *primaryCluster.ClusterState
-	if primaryCluster.EsxHostInfo != nil {
-		cluster["host_instance_type"] = *primaryCluster.EsxHostInfo.InstanceType
-	}
-	if primaryCluster.MsftLicenseConfig != nil {
-		if primaryCluster.MsftLicenseConfig.MssqlLicensing != nil {
-			cluster["mssql_licensing"] = *primaryCluster.MsftLicenseConfig.MssqlLicensing
-		}
-		if primaryCluster.MsftLicenseConfig.WindowsLicensing != nil {
-			cluster["windows_licensing"] = *primaryCluster.MsftLicenseConfig.WindowsLicensing
-		}
-	}
-	if err := d.Set("cluster_info", cluster); err != nil {
+	if err := d.Set("cluster_info", flattenClusterInfoV0(primaryCluster)); err != nil {
 		return err
 	}
 	if sddc.ResourceConfig != nil {
-		if err := d.Set("vc_url", sddc.ResourceConfig.VcUrl); err != nil {
+		var clusters []map[string]interface{}
+		for _, cluster := range sddc.ResourceConfig.Clusters {
+			clusters = append(clusters, flattenClusterInfo(cluster))
+		}
+		if err := d.Set("clusters", clusters); err != nil {
 			return err
 		}
-		if err := d.Set("cloud_username", sddc.ResourceConfig.CloudUsername); err != nil {
+		if err := d.Set("vc_url", sddc.ResourceConfig.VcUrl); err != nil {
 			return err
 		}
-		if err := d.Set("cloud_password", sddc.ResourceConfig.CloudPassword); err != nil {
+		if err := d.Set("cloud_username", sddc.ResourceConfig.CloudUsername); err != nil {
 			return err
 		}
 		if err := d.Set("nsxt_reverse_proxy_url", sddc.ResourceConfig.NsxApiPublicEndpointUrl); err != nil {
@@ -508,6 +891,13 @@ func resourceSddcRead(d *schema.ResourceData, m interface{}) error {
 		if err := d.Set("num_host", getHostCountCluster(&sddc, primaryCluster.ClusterId)); err != nil {
 			return err
 		}
+		for _, cluster := range sddc.ResourceConfig.Clusters {
+			if cluster.ClusterId == primaryCluster.ClusterId {
+				if err := setStorageCapacity(d, cluster); err != nil {
+					return err
+				}
+			}
+		}
 		if sddc.ResourceConfig.VpcInfo != nil && sddc.ResourceConfig.VpcInfo.VpcCidr != nil {
 			if err := d.Set("vpc_cidr", *sddc.ResourceConfig.VpcInfo.VpcCidr); err != nil {
 				return err
@@ -529,17 +919,6 @@ func resourceSddcRead(d *schema.ResourceData, m interface{}) error {
 			if err := d.Set("nsxt_cloudadmin", *sddc.ResourceConfig.NsxCloudAdmin); err != nil {
 				return err
 			}
-			// Evade nil pointer dereference when user's access_token doesn't have NSX roles
-			if sddc.ResourceConfig.NsxCloudAdminPassword != nil {
-				if err := d.Set("nsxt_cloudadmin_password", *sddc.ResourceConfig.NsxCloudAdminPassword); err != nil {
-					return err
-				}
-			}
-			if sddc.ResourceConfig.NsxCloudAuditPassword != nil {
-				if err := d.Set("nsxt_cloudaudit_password", *sddc.ResourceConfig.NsxCloudAuditPassword); err != nil {
-					return err
-				}
-			}
 			if err := d.Set("nsxt_cloudaudit", *sddc.ResourceConfig.NsxCloudAudit); err != nil {
 				return err
 			}
@@ -551,6 +930,9 @@ func resourceSddcRead(d *schema.ResourceData, m interface{}) error {
 			}
 		}
 	}
+	if err := setSddcCredentials(d, &sddc, d.Get("omit_credentials_from_state").(bool)); err != nil {
+		return err
+	}
 	edrsPolicyClient := autoscalercluster.NewEdrsPolicyClient(connectorWrapper.Connector)
 	edrsPolicy, err := edrsPolicyClient.Get(orgID, sddcID, primaryCluster.ClusterId)
 	if err != nil {
@@ -689,6 +1071,13 @@ func resourceSddcUpdate(d *schema.ResourceData, m interface{}) error {
 			NumHosts:  int64(diffNum),
 			ClusterId: &primaryClusterID,
 		}
+		if action == "remove" {
+			removeHostIDs, err := getRemoveHostIDs(d, connectorWrapper, sddcID, primaryClusterID, diffNum)
+			if err != nil {
+				return HandleUpdateError("SDDC", err)
+			}
+			esxConfig.Esxs = removeHostIDs
+		}
 
 		hostUpdateTask, err := esxsClient.Create(orgID, sddcID, esxConfig, &action)
 
@@ -758,8 +1147,8 @@ func resourceSddcUpdate(d *schema.ResourceData, m interface{}) error {
 		maxHosts := int64(d.Get("max_hosts").(int))
 		policyType := d.Get("edrs_policy_type").(string)
 		enableEDRS := d.Get("enable_edrs").(bool)
-		if policyType == constants.StorageScaleUpPolicyType && !enableEDRS {
-			return fmt.Errorf("EDRS policy %s is the default and cannot be disabled", constants.StorageScaleUpPolicyType)
+		if err := validateEdrsPolicy(policyType, enableEDRS, int(minHosts), int(maxHosts)); err != nil {
+			return err
 		}
 		edrsPolicy := &autoscalermodel.EdrsPolicy{
 			EnableEdrs: enableEDRS,
@@ -775,7 +1164,7 @@ func resourceSddcUpdate(d *schema.ResourceData, m interface{}) error {
 
 		return retry.RetryContext(context.Background(), d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 			taskErr := task.RetryTaskUntilFinished(connectorWrapper, func() (model.Task, error) {
-				return task.GetTask(connectorWrapper, edrsPolicyUpdateTask.Id)
+				return task.GetAutoscalerTask(connectorWrapper, edrsPolicyUpdateTask.Id)
 			}, "failed to update EDRS policy configuration", nil)
 			if taskErr != nil {
 				return taskErr
@@ -853,6 +1242,11 @@ func buildAwsSddcConfig(d *schema.ResourceData) (*model.AwsSddcConfig, error) {
 	skipCreatingVxlan := d.Get("skip_creating_vxlan").(bool)
 	ssoDomain := d.Get("sso_domain").(string)
 	sddcTemplateID := d.Get("sddc_template_id").(string)
+	if sddcTemplateID != "" {
+		if err := validateSddcTemplateArguments(d); err != nil {
+			return nil, err
+		}
+	}
 	deploymentType := d.Get("deployment_type").(string)
 	region := d.Get("region").(string)
 
@@ -896,11 +1290,222 @@ func buildAwsSddcConfig(d *schema.ResourceData) (*model.AwsSddcConfig, error) {
 		HostInstanceType:      &hostInstanceType,
 		Size:                  &sddcSize,
 		MsftLicenseConfig:     nil,
+		StorageCapacity:       storageCapacityPtr(d.Get("storage_capacity").(string)),
 	}
 
 	return &model, nil
 }
 
+// sddcTemplateArguments the arguments whose values are taken from the SDDC
+// template when sddc_template_id is set.
+var sddcTemplateArguments = []string{"vpc_cidr", "vxlan_subnet", "account_link_sddc_config"}
+
+// validateSddcTemplateArguments checks that the arguments applied from the SDDC
+// template are not also set in the configuration.
+func validateSddcTemplateArguments(d *schema.ResourceData) error {
+	var conflictingArguments []string
+	for _, argument := range sddcTemplateArguments {
+		if _, ok := d.GetOk(argument); ok {
+			conflictingArguments = append(conflictingArguments, argument)
+		}
+	}
+	if len(conflictingArguments) > 0 {
+		return fmt.Errorf("%s cannot be set with sddc_template_id, the values are taken from the SDDC template",
+			strings.Join(conflictingArguments, ", "))
+	}
+	return nil
+}
+
+// reservedSddcCidrs networks that cannot be used for the SDDC management network.
+var reservedSddcCidrs = []string{"10.0.0.0/15", "172.31.0.0/16"}
+
+// sddcPreflightAttributes maps keywords found in the messages of the VMC SDDC
+// validation API to the argument they refer to. The first matching keyword wins.
+var sddcPreflightAttributes = []struct {
+	keyword   string
+	attribute string
+}{
+	{"vxlan", "vxlan_subnet"},
+	{"subnet", "account_link_sddc_config"},
+	{"connected account", "account_link_sddc_config"},
+	{"linked account", "account_link_sddc_config"},
+	{"cidr", "vpc_cidr"},
+	{"vpc", "vpc_cidr"},
+	{"instance type", "host_instance_type"},
+	{"instance_type", "host_instance_type"},
+	{"region", "region"},
+	{"quota", "num_host"},
+	{"host", "num_host"},
+}
+
+// validateSddcConfig runs the SDDC pre-flight validation. The configuration is checked
+// locally, against the provisioning spec of the organization and finally by the
+// VMC API in validate only mode. Every problem found is reported as a separate
+// diagnostic, pointing to the offending argument where possible.
+func validateSddcConfig(connectorWrapper *connector.Wrapper, awsSddcConfig *model.AwsSddcConfig) diag.Diagnostics {
+	orgID := connectorWrapper.OrgID
+	diags := validateSddcNetworks(awsSddcConfig)
+
+	provisionSpec, err := sddcs.NewProvisionSpecClient(connectorWrapper).Get(orgID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to validate SDDC configuration against the provisioning spec",
+			Detail:   HandleDataSourceReadError("SDDC provisioning spec", err).Error(),
+		})
+	} else {
+		diags = append(diags, validateSddcProvisionSpec(awsSddcConfig, provisionSpec)...)
+	}
+
+	validateOnly := true
+	_, err = orgs.NewSddcsClient(connectorWrapper).Create(orgID, *awsSddcConfig, &validateOnly)
+	if err != nil {
+		for _, message := range apiErrorMessages("SDDC configuration validation failed", err) {
+			diags = append(diags, sddcPreflightError(sddcPreflightAttribute(message), message))
+		}
+	}
+	return diags
+}
+
+// validateSddcNetworks checks the vpc_cidr and vxlan_subnet arguments without
+// calling the VMC API.
+func validateSddcNetworks(awsSddcConfig *model.AwsSddcConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var vpcNetwork *net.IPNet
+	if awsSddcConfig.VpcCidr != nil && len(*awsSddcConfig.VpcCidr) > 0 {
+		ip, network, err := net.ParseCIDR(*awsSddcConfig.VpcCidr)
+		if err != nil {
+			diags = append(diags, sddcPreflightError("vpc_cidr", fmt.Sprintf("invalid CIDR %q: %v", *awsSddcConfig.VpcCidr, err)))
+		} else {
+			vpcNetwork = network
+			prefix, _ := network.Mask.Size()
+			if prefix != 16 && prefix != 20 && prefix != 23 {
+				diags = append(diags, sddcPreflightError("vpc_cidr", fmt.Sprintf("only prefix of 16, 20 and 23 is supported, got %d", prefix)))
+			}
+			if !ip.IsPrivate() {
+				diags = append(diags, sddcPreflightError("vpc_cidr", fmt.Sprintf("%s is not a private (RFC 1918) range", *awsSddcConfig.VpcCidr)))
+			}
+			for _, reservedCidr := range reservedSddcCidrs {
+				_, reservedNetwork, _ := net.ParseCIDR(reservedCidr)
+				if networksOverlap(network, reservedNetwork) {
+					diags = append(diags, sddcPreflightError("vpc_cidr", fmt.Sprintf("%s overlaps with the reserved range %s", *awsSddcConfig.VpcCidr, reservedCidr)))
+				}
+			}
+		}
+	}
+	skipCreatingVxlan := awsSddcConfig.SkipCreatingVxlan != nil && *awsSddcConfig.SkipCreatingVxlan
+	if !skipCreatingVxlan && awsSddcConfig.VxlanSubnet != nil && len(*awsSddcConfig.VxlanSubnet) > 0 {
+		_, vxlanNetwork, err := net.ParseCIDR(*awsSddcConfig.VxlanSubnet)
+		if err != nil {
+			diags = append(diags, sddcPreflightError("vxlan_subnet", fmt.Sprintf("invalid CIDR %q: %v", *awsSddcConfig.VxlanSubnet, err)))
+		} else if vpcNetwork != nil && networksOverlap(vpcNetwork, vxlanNetwork) {
+			diags = append(diags, sddcPreflightError("vxlan_subnet", fmt.Sprintf("%s overlaps with vpc_cidr %s", *awsSddcConfig.VxlanSubnet, *awsSddcConfig.VpcCidr)))
+		}
+	}
+	for _, config := range awsSddcConfig.AccountLinkSddcConfig {
+		for _, subnetID := range config.CustomerSubnetIds {
+			if !strings.HasPrefix(subnetID, "subnet-") {
+				diags = append(diags, sddcPreflightError("account_link_sddc_config", fmt.Sprintf("%q is not an AWS subnet ID", subnetID)))
+			}
+		}
+	}
+	return diags
+}
+
+// validateSddcProvisionSpec checks the region, host instance type and number of hosts
+// against what the organization is allowed to deploy. Providers and SDDC types
+// missing from the provisioning spec are not validated.
+func validateSddcProvisionSpec(awsSddcConfig *model.AwsSddcConfig, provisionSpec model.ProvisionSpec) diag.Diagnostics {
+	providerSpec, ok := provisionSpec.Provider[awsSddcConfig.Provider]
+	if !ok {
+		return nil
+	}
+	sddcType := "DEFAULT"
+	if awsSddcConfig.SddcType != nil && len(*awsSddcConfig.SddcType) > 0 {
+		sddcType = *awsSddcConfig.SddcType
+	}
+	configSpec, ok := providerSpec.SddcTypeConfigSpec[sddcType]
+	if !ok {
+		return nil
+	}
+	var diags diag.Diagnostics
+	region := ""
+	if awsSddcConfig.Region != nil {
+		region = strings.ReplaceAll(strings.ToUpper(*awsSddcConfig.Region), "-", "_")
+	}
+	instanceTypes, ok := configSpec.Availability[region]
+	if !ok {
+		var regions []string
+		for availableRegion := range configSpec.Availability {
+			regions = append(regions, availableRegion)
+		}
+		sort.Strings(regions)
+		return append(diags, sddcPreflightError("region", fmt.Sprintf("region %q is not available for %s SDDCs, available regions: %s",
+			region, sddcType, strings.Join(regions, ", "))))
+	}
+	if awsSddcConfig.HostInstanceType == nil || len(*awsSddcConfig.HostInstanceType) == 0 {
+		return diags
+	}
+	var availableInstanceTypes []string
+	for _, instanceTypeConfig := range instanceTypes {
+		if instanceTypeConfig.InstanceType == nil {
+			continue
+		}
+		if *instanceTypeConfig.InstanceType != *awsSddcConfig.HostInstanceType {
+			availableInstanceTypes = append(availableInstanceTypes, *instanceTypeConfig.InstanceType)
+			continue
+		}
+		if instanceTypeConfig.InstanceProvisioningErrorCause != nil && len(*instanceTypeConfig.InstanceProvisioningErrorCause) > 0 {
+			diags = append(diags, sddcPreflightError("host_instance_type", fmt.Sprintf("%s cannot be provisioned in region %s: %s",
+				*awsSddcConfig.HostInstanceType, region, *instanceTypeConfig.InstanceProvisioningErrorCause)))
+		}
+		if len(instanceTypeConfig.Hosts) > 0 && !containsInt64(instanceTypeConfig.Hosts, awsSddcConfig.NumHosts) {
+			diags = append(diags, sddcPreflightError("num_host", fmt.Sprintf("%d hosts of type %s are not supported in region %s, allowed values: %v",
+				awsSddcConfig.NumHosts, *awsSddcConfig.HostInstanceType, region, instanceTypeConfig.Hosts)))
+		}
+		return diags
+	}
+	return append(diags, sddcPreflightError("host_instance_type", fmt.Sprintf("%s is not available in region %s, available instance types: %s",
+		*awsSddcConfig.HostInstanceType, region, strings.Join(availableInstanceTypes, ", "))))
+}
+
+// sddcPreflightAttribute returns the argument that a VMC validation message most
+// likely refers to, or an empty string if it cannot be determined.
+func sddcPreflightAttribute(message string) string {
+	lowerCaseMessage := strings.ToLower(message)
+	for _, mapping := range sddcPreflightAttributes {
+		if strings.Contains(lowerCaseMessage, mapping.keyword) {
+			return mapping.attribute
+		}
+	}
+	return ""
+}
+
+func sddcPreflightError(attribute string, detail string) diag.Diagnostic {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "SDDC pre-flight validation failed",
+		Detail:   detail,
+	}
+	if len(attribute) > 0 {
+		diagnostic.AttributePath = cty.GetAttrPath(attribute)
+	}
+	return diagnostic
+}
+
+func networksOverlap(first *net.IPNet, second *net.IPNet) bool {
+	return first.Contains(second.IP) || second.Contains(first.IP)
+}
+
+func containsInt64(values []int64, value int64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func expandAccountLinkSddcConfig(l []interface{}) []model.AccountLinkSddcConfig {
 
 	if len(l) == 0 {
@@ -0,0 +1,216 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs/sddcs/dns"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+	"github.com/vmware/terraform-provider-vmc/vmc/task"
+)
+
+func resourceSddcDNS() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSddcDNSCreate,
+		Read:   resourceSddcDNSRead,
+		Update: resourceSddcDNSUpdate,
+		Delete: resourceSddcDNSDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: sddcDNSSchema(),
+	}
+}
+
+// sddcDNSSchema this helper function extracts the creation of the SDDC DNS
+// schema, so that it's made available for mocking in tests.
+func sddcDNSSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sddc_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "SDDC identifier.",
+		},
+		"ip_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{constants.PublicIPType, constants.PrivateIPType}, true),
+			DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+				return strings.EqualFold(o, n)
+			},
+			Description: "The IP addresses the vCenter and NSX Manager FQDNs resolve to: public or private.",
+		},
+		"initial_ip_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP addresses the FQDNs resolved to before they were managed by the resource, restored on destroy.",
+		},
+		"vc_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "vCenter URL.",
+		},
+		"vc_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "IP address the vCenter FQDN resolves to.",
+		},
+		"vc_public_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public IP address of vCenter.",
+		},
+		"vc_management_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Private IP address of vCenter.",
+		},
+		"nsx_mgr_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "NSX Manager URL.",
+		},
+		"nsx_mgr_management_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Private IP address of NSX Manager.",
+		},
+	}
+}
+
+func resourceSddcDNSCreate(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	sddcID := d.Get("sddc_id").(string)
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		return HandleCreateError("SDDC DNS", err)
+	}
+	if sddc.ResourceConfig == nil {
+		return HandleCreateError("SDDC DNS", fmt.Errorf("SDDC %s has no resource configuration", sddcID))
+	}
+	if err := d.Set("initial_ip_type", flattenSddcDNS(*sddc.ResourceConfig)["ip_type"]); err != nil {
+		return err
+	}
+	if err := updateSddcDNS(d, m, d.Get("ip_type").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return HandleCreateError("SDDC DNS", err)
+	}
+	d.SetId(sddcID)
+	return resourceSddcDNSRead(d, m)
+}
+
+func resourceSddcDNSRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	sddcID := d.Id()
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		return HandleReadError(d, "SDDC DNS", sddcID, err)
+	}
+	if *sddc.SddcState == "DELETED" || sddc.ResourceConfig == nil {
+		log.Printf("Unable to retrieve SDDC with ID %s", sddc.Id)
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("sddc_id", sddcID); err != nil {
+		return err
+	}
+	dnsAttributes := flattenSddcDNS(*sddc.ResourceConfig)
+	for key, value := range dnsAttributes {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	// Imported resources start from the DNS resolution they were imported with
+	if d.Get("initial_ip_type").(string) == "" {
+		return d.Set("initial_ip_type", dnsAttributes["ip_type"])
+	}
+	return nil
+}
+
+func resourceSddcDNSUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("ip_type") {
+		if err := updateSddcDNS(d, m, d.Get("ip_type").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return HandleUpdateError("SDDC DNS", err)
+		}
+	}
+	return resourceSddcDNSRead(d, m)
+}
+
+// resourceSddcDNSDelete restores the IP addresses the vCenter and NSX Manager
+// FQDNs resolved to before they were managed by the resource.
+func resourceSddcDNSDelete(d *schema.ResourceData, m interface{}) error {
+	initialIPType := d.Get("initial_ip_type").(string)
+	if initialIPType != "" && !strings.EqualFold(initialIPType, d.Get("ip_type").(string)) {
+		if err := updateSddcDNS(d, m, initialIPType, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return HandleDeleteError("SDDC DNS", d.Id(), err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// updateSddcDNS updates the DNS records of the management VMs and waits for
+// the task to finish.
+func updateSddcDNS(d *schema.ResourceData, m interface{}, ipType string, timeout time.Duration) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	orgID := connectorWrapper.OrgID
+	sddcID := d.Get("sddc_id").(string)
+
+	var dnsUpdateTask model.Task
+	var err error
+	if strings.EqualFold(ipType, constants.PrivateIPType) {
+		dnsUpdateTask, err = dns.NewPrivateClient(connectorWrapper).Update(orgID, sddcID)
+	} else {
+		dnsUpdateTask, err = dns.NewPublicClient(connectorWrapper).Update(orgID, sddcID)
+	}
+	if err != nil {
+		return err
+	}
+	return retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		return task.RetryTaskUntilFinished(connectorWrapper,
+			func() (model.Task, error) {
+				return task.GetTask(connectorWrapper, dnsUpdateTask.Id)
+			},
+			"error updating DNS records of SDDC "+sddcID,
+			nil)
+	})
+}
+
+// flattenSddcDNS the DNS resolution of the management VMs of the SDDC and their
+// addresses.
+func flattenSddcDNS(resourceConfig model.AwsSddcResourceConfig) map[string]interface{} {
+	ipType := constants.PublicIPType
+	vcIP := stringValue(resourceConfig.VcPublicIp)
+	if boolValue(resourceConfig.DnsWithManagementVmPrivateIp) {
+		ipType = constants.PrivateIPType
+		vcIP = stringValue(resourceConfig.VcManagementIp)
+	}
+	return map[string]interface{}{
+		"ip_type":               ipType,
+		"vc_url":                stringValue(resourceConfig.VcUrl),
+		"vc_ip":                 vcIP,
+		"vc_public_ip":          stringValue(resourceConfig.VcPublicIp),
+		"vc_management_ip":      stringValue(resourceConfig.VcManagementIp),
+		"nsx_mgr_url":           stringValue(resourceConfig.NsxMgrUrl),
+		"nsx_mgr_management_ip": stringValue(resourceConfig.NsxMgrManagementIp),
+	}
+}
@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+)
+
+func TestAccResourceVmcSddcDNSZerocloud(t *testing.T) {
+	resourceName := "vmc_sddc_dns.dns_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcSddcDNSConfig(constants.PrivateIPType),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_type", constants.PrivateIPType),
+					resource.TestCheckResourceAttrPair(resourceName, "vc_ip", resourceName, "vc_management_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "initial_ip_type"),
+				),
+			},
+			{
+				Config: testAccVmcSddcDNSConfig(constants.PublicIPType),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_type", constants.PublicIPType),
+					resource.TestCheckResourceAttrPair(resourceName, "vc_ip", resourceName, "vc_public_ip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported with the DNS resolution of the SDDC at import time
+				ImportStateVerifyIgnore: []string{"initial_ip_type"},
+			},
+		},
+	})
+}
+
+func testAccVmcSddcDNSConfig(ipType string) string {
+	return fmt.Sprintf(`
+resource "vmc_sddc_dns" "dns_1" {
+  sddc_id = %q
+  ip_type = %q
+}
+`, os.Getenv(constants.TestSddcID), ipType,
+	)
+}
+
+func TestFlattenSddcDNS(t *testing.T) {
+	vcPublicIP := "203.0.113.10"
+	vcManagementIP := "10.2.224.4"
+	privateDNS := true
+	resourceConfig := model.AwsSddcResourceConfig{
+		VcPublicIp:     &vcPublicIP,
+		VcManagementIp: &vcManagementIP,
+	}
+
+	dnsAttributes := flattenSddcDNS(resourceConfig)
+	assert.Equal(t, constants.PublicIPType, dnsAttributes["ip_type"])
+	assert.Equal(t, vcPublicIP, dnsAttributes["vc_ip"])
+
+	resourceConfig.DnsWithManagementVmPrivateIp = &privateDNS
+	dnsAttributes = flattenSddcDNS(resourceConfig)
+	assert.Equal(t, constants.PrivateIPType, dnsAttributes["ip_type"])
+	assert.Equal(t, vcManagementIP, dnsAttributes["vc_ip"])
+}
@@ -0,0 +1,276 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/connector"
+	"github.com/vmware/terraform-provider-vmc/vmc/datastore"
+	"github.com/vmware/terraform-provider-vmc/vmc/task"
+)
+
+func resourceSddcNfsDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSddcNfsDatastoreCreate,
+		Read:   resourceSddcNfsDatastoreRead,
+		Update: resourceSddcNfsDatastoreUpdate,
+		Delete: resourceSddcNfsDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ",")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected datastore_id,sddc_id", d.Id())
+				}
+				if err := IsValidUUID(idParts[1]); err != nil {
+					return nil, fmt.Errorf("invalid format for sddc_id : %v", err)
+				}
+
+				d.SetId(idParts[0])
+				if err := d.Set("sddc_id", idParts[1]); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: nfsDatastoreClustersCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"sddc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "SDDC identifier.",
+			},
+			"datastore_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the datastore in vCenter.",
+			},
+			"nfs_server": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "IP address or FQDN of the NFS server, for example the NFS endpoint of an FSx for NetApp ONTAP storage virtual machine.",
+			},
+			"nfs_export": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Export path of the NFS volume.",
+			},
+			"cluster_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the clusters of the SDDC the datastore is attached to.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the datastore.",
+			},
+		},
+	}
+}
+
+func resourceSddcNfsDatastoreCreate(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	datastoreClient := datastore.NewDatastoreClient(*connectorWrapper)
+	if err := datastoreClient.Authenticate(); err != nil {
+		return fmt.Errorf("authentication error from Cloud Service Provider: %s", err)
+	}
+	sddcID := d.Get("sddc_id").(string)
+	datastoreName := d.Get("datastore_name").(string)
+
+	config := datastore.NewAwsAttachNfsDatastoreConfig(datastoreName, d.Get("nfs_server").(string),
+		d.Get("nfs_export").(string), expandStringSet(d.Get("cluster_ids").(*schema.Set)))
+	taskID, err := datastoreClient.AttachDatastore(sddcID, *config)
+	if err != nil {
+		return HandleCreateError("NFS datastore", err)
+	}
+	if err := waitForNfsDatastoreTask(connectorWrapper, taskID, d.Timeout(schema.TimeoutCreate),
+		"error attaching NFS datastore "+datastoreName); err != nil {
+		return err
+	}
+	datastores, err := datastoreClient.ListDatastores(sddcID)
+	if err != nil {
+		return HandleCreateError("NFS datastore", err)
+	}
+	datastoreID, err := findNfsDatastoreID(datastores, datastoreName)
+	if err != nil {
+		return HandleCreateError("NFS datastore", err)
+	}
+	d.SetId(datastoreID)
+	return resourceSddcNfsDatastoreRead(d, m)
+}
+
+func resourceSddcNfsDatastoreRead(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	datastoreClient := datastore.NewDatastoreClient(*connectorWrapper)
+	if err := datastoreClient.Authenticate(); err != nil {
+		return fmt.Errorf("authentication error from Cloud Service Provider: %s", err)
+	}
+	nfsDatastore, err := datastoreClient.GetDatastore(d.Id())
+	if err == datastore.ErrDatastoreNotFound || (err == nil && nfsDatastore.Deleted) {
+		log.Printf("NFS datastore with ID %s not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return HandleReadError(d, "NFS datastore", d.Id(), err)
+	}
+	if err := d.Set("sddc_id", nfsDatastore.SddcID); err != nil {
+		return err
+	}
+	if err := d.Set("datastore_name", nfsDatastore.Name); err != nil {
+		return err
+	}
+	if err := d.Set("nfs_server", nfsDatastore.NfsServer); err != nil {
+		return err
+	}
+	if err := d.Set("nfs_export", nfsDatastore.NfsExport); err != nil {
+		return err
+	}
+	if err := d.Set("cluster_ids", nfsDatastore.ClusterIDs); err != nil {
+		return err
+	}
+	return d.Set("state", nfsDatastore.State)
+}
+
+func resourceSddcNfsDatastoreUpdate(d *schema.ResourceData, m interface{}) error {
+	if !d.HasChange("cluster_ids") {
+		return resourceSddcNfsDatastoreRead(d, m)
+	}
+	connectorWrapper := m.(*connector.Wrapper)
+	datastoreClient := datastore.NewDatastoreClient(*connectorWrapper)
+	if err := datastoreClient.Authenticate(); err != nil {
+		return fmt.Errorf("authentication error from Cloud Service Provider: %s", err)
+	}
+	sddcID := d.Get("sddc_id").(string)
+	oldClusterIDs, newClusterIDs := d.GetChange("cluster_ids")
+	clusterIDsToAttach := expandStringSet(newClusterIDs.(*schema.Set).Difference(oldClusterIDs.(*schema.Set)))
+	clusterIDsToDetach := expandStringSet(oldClusterIDs.(*schema.Set).Difference(newClusterIDs.(*schema.Set)))
+
+	if len(clusterIDsToAttach) > 0 {
+		config := datastore.NewAwsAttachDatastoreToClustersConfig(d.Id(), clusterIDsToAttach)
+		taskID, err := datastoreClient.AttachDatastore(sddcID, *config)
+		if err != nil {
+			return HandleUpdateError("NFS datastore", err)
+		}
+		if err := waitForNfsDatastoreTask(connectorWrapper, taskID, d.Timeout(schema.TimeoutUpdate),
+			"error attaching NFS datastore "+d.Id()); err != nil {
+			return err
+		}
+	}
+	if len(clusterIDsToDetach) > 0 {
+		config := datastore.NewAwsDetachNfsDatastoreConfig(d.Id(), clusterIDsToDetach)
+		taskID, err := datastoreClient.DetachDatastore(sddcID, *config)
+		if err != nil {
+			return HandleUpdateError("NFS datastore", err)
+		}
+		if err := waitForNfsDatastoreTask(connectorWrapper, taskID, d.Timeout(schema.TimeoutUpdate),
+			"error detaching NFS datastore "+d.Id()); err != nil {
+			return err
+		}
+	}
+	return resourceSddcNfsDatastoreRead(d, m)
+}
+
+func resourceSddcNfsDatastoreDelete(d *schema.ResourceData, m interface{}) error {
+	connectorWrapper := m.(*connector.Wrapper)
+	datastoreClient := datastore.NewDatastoreClient(*connectorWrapper)
+	if err := datastoreClient.Authenticate(); err != nil {
+		return fmt.Errorf("authentication error from Cloud Service Provider: %s", err)
+	}
+	config := datastore.NewAwsDetachNfsDatastoreConfig(d.Id(), expandStringSet(d.Get("cluster_ids").(*schema.Set)))
+	taskID, err := datastoreClient.DetachDatastore(d.Get("sddc_id").(string), *config)
+	if err != nil {
+		return HandleDeleteError("NFS datastore", d.Id(), err)
+	}
+	if err := waitForNfsDatastoreTask(connectorWrapper, taskID, d.Timeout(schema.TimeoutDelete),
+		"error detaching NFS datastore "+d.Id()); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func waitForNfsDatastoreTask(connectorWrapper *connector.Wrapper, taskID string, timeout time.Duration, message string) error {
+	return retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		return task.RetryTaskUntilFinished(connectorWrapper, func() (model.Task, error) {
+			return task.GetV2Task(connectorWrapper, taskID)
+		}, message, nil)
+	})
+}
+
+// nfsDatastoreClustersCustomizeDiff checks at plan time that the clusters the
+// datastore is attached to belong to the SDDC. The check is skipped when the
+// SDDC cannot be retrieved, leaving it to the VMC API.
+func nfsDatastoreClustersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	connectorWrapper, ok := m.(*connector.Wrapper)
+	if !ok || !d.NewValueKnown("sddc_id") || !d.NewValueKnown("cluster_ids") || !d.HasChange("cluster_ids") {
+		return nil
+	}
+	sddcID := d.Get("sddc_id").(string)
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		log.Printf("[WARN] Unable to check the clusters of SDDC %s: %v", sddcID, err)
+		return nil
+	}
+	return checkClustersInSddc(expandStringSet(d.Get("cluster_ids").(*schema.Set)), sddc)
+}
+
+// checkClustersInSddc returns an error naming the clusters that are not part of
+// the SDDC.
+func checkClustersInSddc(clusterIDs []string, sddc model.Sddc) error {
+	var sddcClusterIDs []string
+	if sddc.ResourceConfig != nil {
+		for _, cluster := range sddc.ResourceConfig.Clusters {
+			sddcClusterIDs = append(sddcClusterIDs, cluster.ClusterId)
+		}
+	}
+	var unknownClusterIDs []string
+	for _, clusterID := range clusterIDs {
+		if !slices.Contains(sddcClusterIDs, clusterID) {
+			unknownClusterIDs = append(unknownClusterIDs, clusterID)
+		}
+	}
+	if len(unknownClusterIDs) > 0 {
+		return fmt.Errorf("clusters %s do not belong to SDDC %s", strings.Join(unknownClusterIDs, ", "), sddc.Id)
+	}
+	return nil
+}
+
+// findNfsDatastoreID looks up the ID of the datastore with the given name,
+// ignoring the deleted datastores.
+func findNfsDatastoreID(datastores []datastore.NfsDatastore, datastoreName string) (string, error) {
+	for _, nfsDatastore := range datastores {
+		if nfsDatastore.Name == datastoreName && !nfsDatastore.Deleted {
+			return nfsDatastore.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no datastore named %q found", datastoreName)
+}
@@ -0,0 +1,95 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
+
+	"github.com/vmware/terraform-provider-vmc/vmc/constants"
+	"github.com/vmware/terraform-provider-vmc/vmc/datastore"
+)
+
+func TestAccResourceVmcSddcNfsDatastoreZerocloud(t *testing.T) {
+	resourceName := "vmc_sddc_nfs_datastore.datastore_1"
+	datastoreName := "terraform_test_datastore_" + acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckZerocloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcSddcNfsDatastoreConfig(datastoreName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "datastore_name", datastoreName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resourceState, ok := state.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return resourceState.Primary.ID + "," + resourceState.Primary.Attributes["sddc_id"], nil
+				},
+			},
+		},
+	})
+}
+
+func testAccVmcSddcNfsDatastoreConfig(datastoreName string) string {
+	return fmt.Sprintf(`
+data "vmc_sddc" "sddc_1" {
+  sddc_id = %q
+}
+
+resource "vmc_sddc_nfs_datastore" "datastore_1" {
+  sddc_id        = data.vmc_sddc.sddc_1.sddc_id
+  datastore_name = %q
+  nfs_server     = "10.0.0.10"
+  nfs_export     = "/vol_terraform"
+  cluster_ids    = [data.vmc_sddc.sddc_1.clusters[0].cluster_id]
+}
+`, os.Getenv(constants.TestSddcID), datastoreName,
+	)
+}
+
+func TestCheckClustersInSddc(t *testing.T) {
+	sddc := model.Sddc{
+		Id: "sddc-1",
+		ResourceConfig: &model.AwsSddcResourceConfig{
+			Clusters: []model.Cluster{{ClusterId: "cluster-1"}, {ClusterId: "cluster-2"}},
+		},
+	}
+	assert.NoError(t, checkClustersInSddc([]string{"cluster-1", "cluster-2"}, sddc))
+	assert.EqualError(t, checkClustersInSddc([]string{"cluster-1", "cluster-3"}, sddc),
+		"clusters cluster-3 do not belong to SDDC sddc-1")
+	assert.Error(t, checkClustersInSddc([]string{"cluster-1"}, model.Sddc{Id: "sddc-2"}))
+}
+
+func TestFindNfsDatastoreID(t *testing.T) {
+	datastores := []datastore.NfsDatastore{
+		{ID: "ds-1", Name: "fsx", Deleted: true},
+		{ID: "ds-2", Name: "fsx"},
+		{ID: "ds-3", Name: "other"},
+	}
+	datastoreID, err := findNfsDatastoreID(datastores, "fsx")
+	assert.NoError(t, err)
+	assert.Equal(t, "ds-2", datastoreID)
+
+	_, err = findNfsDatastoreID(datastores, "missing")
+	assert.EqualError(t, err, "no datastore named \"missing\" found")
+}
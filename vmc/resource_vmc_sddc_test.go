@@ -5,11 +5,14 @@
 package vmc
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -70,6 +73,36 @@ func TestAccResourceVmcSddcZerocloud(t *testing.T) {
 	})
 }
 
+func TestAccResourceVmcSddcImportZerocloud(t *testing.T) {
+	var sddcResource model.Sddc
+	sddcName := "terraform_sddc_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckZerocloud(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVmcSddcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmcSddcConfigZerocloud(sddcName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVmcSddcExists("vmc_sddc.sddc_zerocloud", &sddcResource),
+					resource.TestCheckResourceAttr("vmc_sddc.sddc_zerocloud", "sddc_state", "READY"),
+				),
+			},
+			{
+				ResourceName:       "vmc_sddc.sddc_zerocloud",
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+			},
+			// the imported state must match the configuration the SDDC was created with
+			{
+				Config:   testAccVmcSddcConfigZerocloud(sddcName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccResourceVmcSddcRequiredFieldsOnlyZerocloud(t *testing.T) {
 	var sddcResource model.Sddc
 	sddcName := "terraform_sddc_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -369,3 +402,224 @@ func TestBuildAwsSddcConfigHostInstanceType(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildAwsSddcConfigStorageCapacity(t *testing.T) {
+	testResourceSchema := schema.TestResourceDataRaw(t, sddcSchema(), map[string]interface{}{
+		"host_instance_type": constants.HostInstancetypeI3EN,
+		"storage_capacity":   "15TB",
+	})
+	got, err := buildAwsSddcConfig(testResourceSchema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15003), *got.StorageCapacity)
+}
+
+func TestSetSddcImportedArguments(t *testing.T) {
+	connectedAccountID := "connected-account-1"
+	size := model.SddcSize_SIZE_LARGE
+	instanceType := model.SddcConfig_HOST_INSTANCE_TYPE_I3EN_METAL
+	enabled := constants.CapitalLicenseConfigEnabled
+	disabled := constants.CapitalLicenseConfigDisabled
+	sddc := model.Sddc{
+		ResourceConfig: &model.AwsSddcResourceConfig{
+			SddcSize: &model.SddcSize{Size: &size},
+			AccountLinkSddcConfig: []model.SddcLinkConfig{
+				{CustomerSubnetIds: []string{"subnet-1", "subnet-2"}, ConnectedAccountId: &connectedAccountID},
+			},
+			MsftLicenseConfig: &model.MsftLicensingConfig{MssqlLicensing: &enabled, WindowsLicensing: &disabled},
+		},
+	}
+	primaryCluster := model.Cluster{
+		EsxHostInfo: &model.EsxHostInfo{InstanceType: &instanceType},
+	}
+
+	testResourceSchema := schema.TestResourceDataRaw(t, sddcSchema(), map[string]interface{}{})
+	assert.NoError(t, setSddcImportedArguments(testResourceSchema, sddc, primaryCluster))
+	assert.Equal(t, constants.LargeSddcSize, testResourceSchema.Get("size"))
+	assert.Equal(t, constants.HostInstancetypeI3EN, testResourceSchema.Get("host_instance_type"))
+	assert.Equal(t, connectedAccountID, testResourceSchema.Get("account_link_sddc_config.0.connected_account_id"))
+	assert.Equal(t, []interface{}{"subnet-1", "subnet-2"}, testResourceSchema.Get("account_link_sddc_config.0.customer_subnet_ids"))
+	assert.Equal(t, enabled, testResourceSchema.Get("microsoft_licensing_config.0.mssql_licensing"))
+	assert.Equal(t, disabled, testResourceSchema.Get("microsoft_licensing_config.0.windows_licensing"))
+	assert.Equal(t, false, testResourceSchema.Get("microsoft_licensing_config.0.academic_license"))
+	assert.Equal(t, constants.MinIntranetMtuLink, testResourceSchema.Get("intranet_mtu_uplink"))
+	assert.Equal(t, false, testResourceSchema.Get("delay_account_link"))
+}
+
+func TestValidateSddcNetworks(t *testing.T) {
+	type test struct {
+		vpcCidr           string
+		vxlanSubnet       string
+		skipCreatingVxlan bool
+		subnetIDs         []string
+		expected          []string
+	}
+
+	tests := []test{
+		{vpcCidr: "10.2.0.0/16", vxlanSubnet: "192.168.1.0/24", subnetIDs: []string{"subnet-0a1b2c3d"}, expected: nil},
+		{vpcCidr: "10.2.0.0/24", expected: []string{"vpc_cidr"}},
+		{vpcCidr: "10.2.0.0", expected: []string{"vpc_cidr"}},
+		{vpcCidr: "8.8.0.0/16", expected: []string{"vpc_cidr"}},
+		{vpcCidr: "10.0.0.0/16", expected: []string{"vpc_cidr"}},
+		{vpcCidr: "10.2.0.0/16", vxlanSubnet: "10.2.1.0/24", expected: []string{"vxlan_subnet"}},
+		{vpcCidr: "10.2.0.0/16", vxlanSubnet: "10.2.1.0/24", skipCreatingVxlan: true, expected: nil},
+		{vpcCidr: "10.2.0.0/24", vxlanSubnet: "bad", subnetIDs: []string{"vpc-0a1b2c3d"},
+			expected: []string{"vpc_cidr", "vxlan_subnet", "account_link_sddc_config"}},
+	}
+
+	for _, testCase := range tests {
+		awsSddcConfig := &model.AwsSddcConfig{
+			VpcCidr:               &testCase.vpcCidr,
+			VxlanSubnet:           &testCase.vxlanSubnet,
+			SkipCreatingVxlan:     &testCase.skipCreatingVxlan,
+			AccountLinkSddcConfig: []model.AccountLinkSddcConfig{{CustomerSubnetIds: testCase.subnetIDs}},
+		}
+		assert.Equal(t, testCase.expected, diagnosticAttributes(validateSddcNetworks(awsSddcConfig)))
+	}
+}
+
+func TestValidateSddcProvisionSpec(t *testing.T) {
+	i3Metal := model.SddcConfig_HOST_INSTANCE_TYPE_I3_METAL
+	i3enMetal := model.SddcConfig_HOST_INSTANCE_TYPE_I3EN_METAL
+	i4iMetal := model.SddcConfig_HOST_INSTANCE_TYPE_I4I_METAL
+	noCapacity := "insufficient capacity"
+	provisionSpec := model.ProvisionSpec{
+		Provider: map[string]model.SddcConfigSpec{
+			constants.AwsProviderType: {
+				SddcTypeConfigSpec: map[string]model.ConfigSpec{
+					"DEFAULT": {
+						Availability: map[string][]model.InstanceTypeConfig{
+							"US_WEST_2": {
+								{InstanceType: &i3Metal, Hosts: []int64{2, 3, 4}},
+								{InstanceType: &i3enMetal, InstanceProvisioningErrorCause: &noCapacity},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	type test struct {
+		provider         string
+		region           string
+		hostInstanceType string
+		numHosts         int64
+		expected         []string
+	}
+
+	tests := []test{
+		{provider: constants.AwsProviderType, region: "US_WEST_2", hostInstanceType: i3Metal, numHosts: 3, expected: nil},
+		{provider: constants.AwsProviderType, region: "us-west-2", hostInstanceType: i3Metal, numHosts: 3, expected: nil},
+		{provider: constants.AwsProviderType, region: "US_WEST_2", numHosts: 3, expected: nil},
+		{provider: constants.AwsProviderType, region: "EU_WEST_1", hostInstanceType: i3Metal, numHosts: 3, expected: []string{"region"}},
+		{provider: constants.AwsProviderType, region: "US_WEST_2", hostInstanceType: i4iMetal, numHosts: 3, expected: []string{"host_instance_type"}},
+		{provider: constants.AwsProviderType, region: "US_WEST_2", hostInstanceType: i3Metal, numHosts: 20, expected: []string{"num_host"}},
+		{provider: constants.AwsProviderType, region: "US_WEST_2", hostInstanceType: i3enMetal, numHosts: 3, expected: []string{"host_instance_type"}},
+		{provider: constants.ZeroCloudProviderType, region: "EU_WEST_1", hostInstanceType: i4iMetal, numHosts: 20, expected: nil},
+	}
+
+	for _, testCase := range tests {
+		awsSddcConfig := &model.AwsSddcConfig{
+			Provider:         testCase.provider,
+			Region:           &testCase.region,
+			HostInstanceType: &testCase.hostInstanceType,
+			NumHosts:         testCase.numHosts,
+		}
+		assert.Equal(t, testCase.expected, diagnosticAttributes(validateSddcProvisionSpec(awsSddcConfig, provisionSpec)))
+	}
+}
+
+func TestSddcPreflightAttribute(t *testing.T) {
+	tests := map[string]string{
+		"The provided subnet subnet-123 is not compatible":         "account_link_sddc_config",
+		"VXLAN subnet overlaps with the management network":        "vxlan_subnet",
+		"Invalid VPC CIDR 10.0.0.0/16":                             "vpc_cidr",
+		"Region EU_NORTH_9 is not supported":                       "region",
+		"Host instance type i3en.metal is not available":           "host_instance_type",
+		"Not enough host quota in the organization to deploy SDDC": "num_host",
+		"Something unexpected happened":                            "",
+	}
+
+	for message, expected := range tests {
+		assert.Equal(t, expected, sddcPreflightAttribute(message))
+	}
+}
+
+// diagnosticAttributes returns the names of the top level attributes the diagnostics point to.
+func diagnosticAttributes(diags diag.Diagnostics) []string {
+	var attributes []string
+	for _, diagnostic := range diags {
+		if len(diagnostic.AttributePath) > 0 {
+			attributes = append(attributes, diagnostic.AttributePath[0].(cty.GetAttrStep).Name)
+		}
+	}
+	return attributes
+}
+
+func TestResourceSddcStateUpgradeV0(t *testing.T) {
+	type test struct {
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}
+
+	tests := []test{
+		{input: map[string]interface{}{
+			"cluster_id": "cluster-1",
+			"cluster_info": map[string]interface{}{
+				"cluster_name":      "Cluster-1",
+				"cluster_state":     "READY",
+				"mssql_licensing":   "DISABLED",
+				"windows_licensing": "ENABLED",
+			},
+		},
+			expected: map[string]interface{}{
+				"cluster_id": "cluster-1",
+				"cluster_info": map[string]interface{}{
+					"cluster_name":      "Cluster-1",
+					"cluster_state":     "READY",
+					"mssql_licensing":   "DISABLED",
+					"windows_licensing": "ENABLED",
+				},
+				"clusters": []interface{}{
+					map[string]interface{}{
+						"cluster_id":        "cluster-1",
+						"cluster_name":      "Cluster-1",
+						"cluster_state":     "READY",
+						"mssql_licensing":   "DISABLED",
+						"windows_licensing": "ENABLED",
+					},
+				},
+			},
+		},
+		{input: map[string]interface{}{
+			"cluster_id": "cluster-1",
+		},
+			expected: map[string]interface{}{
+				"cluster_id": "cluster-1",
+				"clusters":   []interface{}{},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		got, err := resourceSddcStateUpgradeV0(context.Background(), testCase.input, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, got)
+	}
+}
+
+func TestBuildAwsSddcConfigSddcTemplate(t *testing.T) {
+	testResourceSchema := schema.TestResourceDataRaw(t, sddcSchema(), map[string]interface{}{
+		"sddc_template_id": "template-1",
+	})
+	got, err := buildAwsSddcConfig(testResourceSchema)
+	assert.NoError(t, err)
+	assert.Equal(t, "template-1", *got.SddcTemplateId)
+
+	testResourceSchema = schema.TestResourceDataRaw(t, sddcSchema(), map[string]interface{}{
+		"sddc_template_id": "template-1",
+		"vpc_cidr":         "10.2.0.0/16",
+		"vxlan_subnet":     "192.168.1.0/24",
+	})
+	_, err = buildAwsSddcConfig(testResourceSchema)
+	assert.EqualError(t, err, "vpc_cidr, vxlan_subnet cannot be set with sddc_template_id, the values are taken from the SDDC template")
+}
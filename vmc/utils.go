@@ -5,11 +5,15 @@
 package vmc
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/orgs"
@@ -32,11 +36,112 @@ func GetSddc(connector client.Connector, orgID string, sddcID string) (model.Sdd
 	return sddc, err
 }
 
+// storageCapacityHostInstanceTypes the storage_capacity values supported by each
+// host instance type. Host instance types not listed only support the storage
+// attached to the hosts.
+var storageCapacityHostInstanceTypes = map[string][]string{
+	constants.HostInstancetypeI3EN: {"15TB", "20TB", "25TB", "30TB", "35TB"},
+}
+
 func ConvertStorageCapacityToInt(s string) int64 {
 	storageCapacity := storageCapacityMap[s]
 	return storageCapacity
 }
 
+// storageCapacities the sorted keys of storageCapacityMap.
+func storageCapacities() []string {
+	var result []string
+	for storageCapacity := range storageCapacityMap {
+		result = append(result, storageCapacity)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// validateStorageCapacity checks that the storage capacity is supported by the
+// host instance type, an empty host instance type standing for the default one.
+func validateStorageCapacity(hostInstanceType string, storageCapacity string) error {
+	if storageCapacity == "" {
+		return nil
+	}
+	if hostInstanceType == "" {
+		hostInstanceType = constants.HostInstancetypeI3
+	}
+	supportedStorageCapacities, ok := storageCapacityHostInstanceTypes[hostInstanceType]
+	if !ok {
+		var supportedHostInstanceTypes []string
+		for supportedHostInstanceType := range storageCapacityHostInstanceTypes {
+			supportedHostInstanceTypes = append(supportedHostInstanceTypes, supportedHostInstanceType)
+		}
+		sort.Strings(supportedHostInstanceTypes)
+		return fmt.Errorf("storage_capacity is not supported by host instance type %s, only by: %s",
+			hostInstanceType, strings.Join(supportedHostInstanceTypes, ", "))
+	}
+	for _, supportedStorageCapacity := range supportedStorageCapacities {
+		if supportedStorageCapacity == storageCapacity {
+			return nil
+		}
+	}
+	return fmt.Errorf("storage_capacity %s is not supported by host instance type %s, supported values: %s",
+		storageCapacity, hostInstanceType, strings.Join(supportedStorageCapacities, ", "))
+}
+
+// storageCapacityCustomizeDiff validates the storage_capacity against the
+// host_instance_type at plan time, when the resource is created or the storage
+// capacity is changed.
+func storageCapacityCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && !d.HasChange("storage_capacity") {
+		return nil
+	}
+	return validateStorageCapacity(d.Get("host_instance_type").(string), d.Get("storage_capacity").(string))
+}
+
+// storageCapacityPtr returns the storage capacity in GiB to request for the
+// storage_capacity argument, nil when the storage attached to the hosts is used.
+func storageCapacityPtr(storageCapacity string) *int64 {
+	if storageCapacity == "" {
+		return nil
+	}
+	storageCapacityGib := ConvertStorageCapacityToInt(storageCapacity)
+	return &storageCapacityGib
+}
+
+// setStorageCapacity reads back the storage_capacity from the capacity of the
+// cluster, which is the total of its hosts. It is left untouched when the
+// capacity per host isn't one of storageCapacityMap, which is the case for the
+// storage attached to the hosts.
+func setStorageCapacity(d *schema.ResourceData, cluster model.Cluster) error {
+	if cluster.ClusterCapacity == nil || cluster.ClusterCapacity.StorageCapacityGib == nil {
+		return nil
+	}
+	storageCapacity := hostStorageCapacity(*cluster.ClusterCapacity.StorageCapacityGib, len(cluster.EsxHostList))
+	if storageCapacity == "" {
+		return nil
+	}
+	return d.Set("storage_capacity", storageCapacity)
+}
+
+// hostStorageCapacity returns the storage capacity per host of a cluster of
+// numHosts hosts with clusterStorageCapacityGib GiB in total. The capacity
+// reported by the VMC API may be rounded, so a capacity within 1% of one of
+// storageCapacityMap matches. Returns an empty string if none matches.
+func hostStorageCapacity(clusterStorageCapacityGib int64, numHosts int) string {
+	if numHosts <= 0 {
+		return ""
+	}
+	hostStorageCapacityGib := clusterStorageCapacityGib / int64(numHosts)
+	for _, storageCapacity := range storageCapacities() {
+		difference := hostStorageCapacityGib - storageCapacityMap[storageCapacity]
+		if difference < 0 {
+			difference = -difference
+		}
+		if difference*100 <= storageCapacityMap[storageCapacity] {
+			return storageCapacity
+		}
+	}
+	return ""
+}
+
 // ConvertDeployType Mapping for deployment_type field
 // During refresh/import state, return value of VMC API should be converted to uppercamel case in terraform
 // to maintain consistency
@@ -80,7 +185,7 @@ func expandMsftLicenseConfig(config []interface{}) *model.MsftLicensingConfig {
 
 func getNsxtReverseProxyURLConnector(nsxtReverseProxyURL string, wrapper *connector.Wrapper) (client.Connector, error) {
 	if len(nsxtReverseProxyURL) == 0 {
-		return nil, fmt.Errorf("NSX reverse proxy url is required for public IP resource creation")
+		return nil, fmt.Errorf("NSX reverse proxy url is required")
 	}
 	if wrapper == nil {
 		return nil, fmt.Errorf("nil connector.Wrapper provided")
@@ -97,6 +202,48 @@ func getNsxtReverseProxyURLConnector(nsxtReverseProxyURL string, wrapper *connec
 	return copyWrapper.Connector, nil
 }
 
+// nsxtReverseProxyURLCache the NSX reverse proxy URLs resolved from the SDDCs,
+// keyed by SDDC ID, as they do not change during the lifetime of an SDDC.
+var nsxtReverseProxyURLCache sync.Map
+
+// getSddcNsxtReverseProxyURL returns the NSX reverse proxy URL of the SDDC,
+// querying the SDDC only the first time.
+func getSddcNsxtReverseProxyURL(sddcID string, wrapper *connector.Wrapper) (string, error) {
+	if nsxtReverseProxyURL, ok := nsxtReverseProxyURLCache.Load(sddcID); ok {
+		return nsxtReverseProxyURL.(string), nil
+	}
+	if wrapper == nil {
+		return "", fmt.Errorf("nil connector.Wrapper provided")
+	}
+	sddc, err := GetSddc(wrapper, wrapper.OrgID, sddcID)
+	if err != nil {
+		return "", err
+	}
+	if sddc.ResourceConfig == nil || stringValue(sddc.ResourceConfig.NsxApiPublicEndpointUrl) == "" {
+		return "", fmt.Errorf("NSX reverse proxy URL of SDDC %s is not available", sddcID)
+	}
+	nsxtReverseProxyURL := *sddc.ResourceConfig.NsxApiPublicEndpointUrl
+	nsxtReverseProxyURLCache.Store(sddcID, nsxtReverseProxyURL)
+	return nsxtReverseProxyURL, nil
+}
+
+// getNsxtConnector returns a connector to the NSX instance of the SDDC given by
+// the sddc_id argument, or else by the nsxt_reverse_proxy_url argument.
+func getNsxtConnector(d *schema.ResourceData, wrapper *connector.Wrapper) (client.Connector, error) {
+	nsxtReverseProxyURL := d.Get("nsxt_reverse_proxy_url").(string)
+	if sddcID := d.Get("sddc_id").(string); sddcID != "" {
+		var err error
+		nsxtReverseProxyURL, err = getSddcNsxtReverseProxyURL(sddcID, wrapper)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if nsxtReverseProxyURL == "" {
+		return nil, fmt.Errorf("NSX reverse proxy url or SDDC ID is required for public IP resource management")
+	}
+	return getNsxtReverseProxyURLConnector(nsxtReverseProxyURL, wrapper)
+}
+
 // getHostCountCluster tries to find the amount of hosts on a Cluster in
 // the ResourceConfig of the provided SDDC. If there is no ResourceConfig/Cluster 0 is returned.
 // A Cluster is distinguished by its id
@@ -111,6 +258,231 @@ func getHostCountCluster(sddc *model.Sddc, clusterID string) int {
 	return 0
 }
 
+// clusterInfoSchema the schema of a single cluster of an SDDC, as exposed by the
+// clusters/cluster attributes of the SDDC and cluster resources and data sources.
+func clusterInfoSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster identifier.",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the cluster.",
+			},
+			"cluster_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the cluster.",
+			},
+			"host_instance_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance type of the ESX hosts in the cluster.",
+			},
+			"host_cpu_cores_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of CPU cores enabled on each ESX host in the cluster.",
+			},
+			"availability_zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Availability zones the cluster spans.",
+			},
+			"total_number_of_cores": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of CPU cores in the cluster.",
+			},
+			"memory_capacity_gib": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Memory capacity of the cluster in GiB.",
+			},
+			"storage_capacity_gib": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Storage capacity of the cluster in GiB.",
+			},
+			"mssql_licensing": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of MSSQL licensing for the cluster.",
+			},
+			"windows_licensing": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of Windows licensing for the cluster.",
+			},
+			"academic_license": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the Microsoft license is an Academic Standard license.",
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "ESX hosts in the cluster.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"esx_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"esx_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenClusterInfo converts a model.Cluster to the format of clusterInfoSchema
+func flattenClusterInfo(cluster model.Cluster) map[string]interface{} {
+	clusterInfo := map[string]interface{}{
+		"cluster_id":         cluster.ClusterId,
+		"cluster_name":       stringValue(cluster.ClusterName),
+		"cluster_state":      stringValue(cluster.ClusterState),
+		"availability_zones": cluster.AvailabilityZones,
+	}
+	if cluster.EsxHostInfo != nil {
+		clusterInfo["host_instance_type"] = stringValue(cluster.EsxHostInfo.InstanceType)
+	}
+	if cluster.HostCpuCoresCount != nil {
+		clusterInfo["host_cpu_cores_count"] = int(*cluster.HostCpuCoresCount)
+	}
+	if cluster.ClusterCapacity != nil {
+		clusterInfo["total_number_of_cores"] = int(int64Value(cluster.ClusterCapacity.TotalNumberOfCores))
+		clusterInfo["memory_capacity_gib"] = int(int64Value(cluster.ClusterCapacity.MemoryCapacityGib))
+		clusterInfo["storage_capacity_gib"] = int(int64Value(cluster.ClusterCapacity.StorageCapacityGib))
+	}
+	if cluster.MsftLicenseConfig != nil {
+		clusterInfo["mssql_licensing"] = stringValue(cluster.MsftLicenseConfig.MssqlLicensing)
+		clusterInfo["windows_licensing"] = stringValue(cluster.MsftLicenseConfig.WindowsLicensing)
+		if cluster.MsftLicenseConfig.AcademicLicense != nil {
+			clusterInfo["academic_license"] = *cluster.MsftLicenseConfig.AcademicLicense
+		}
+	}
+	var hosts []map[string]interface{}
+	for _, esxHost := range cluster.EsxHostList {
+		hosts = append(hosts, map[string]interface{}{
+			"esx_id":            stringValue(esxHost.EsxId),
+			"name":              stringValue(esxHost.Name),
+			"hostname":          stringValue(esxHost.Hostname),
+			"availability_zone": stringValue(esxHost.AvailabilityZone),
+			"esx_state":         stringValue(esxHost.EsxState),
+			"instance_type":     stringValue(esxHost.InstanceType),
+		})
+	}
+	clusterInfo["hosts"] = hosts
+	return clusterInfo
+}
+
+// clusterInfoV0Schema the cluster_info map kept for one release after its
+// replacement by the block typed attribute.
+func clusterInfoV0Schema(replacement string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Deprecated:  fmt.Sprintf("cluster_info is deprecated and will be removed in the next major release, use %s instead", replacement),
+		Description: "Information about the cluster, such as name, state, host instance type and licensing.",
+	}
+}
+
+// flattenClusterInfoV0 the cluster_info map of the cluster.
+func flattenClusterInfoV0(cluster model.Cluster) map[string]string {
+	clusterInfo := map[string]string{
+		"cluster_name":  stringValue(cluster.ClusterName),
+		"cluster_state": stringValue(cluster.ClusterState),
+	}
+	if cluster.EsxHostInfo != nil {
+		clusterInfo["host_instance_type"] = stringValue(cluster.EsxHostInfo.InstanceType)
+	}
+	if cluster.MsftLicenseConfig != nil {
+		if cluster.MsftLicenseConfig.MssqlLicensing != nil {
+			clusterInfo["mssql_licensing"] = *cluster.MsftLicenseConfig.MssqlLicensing
+		}
+		if cluster.MsftLicenseConfig.WindowsLicensing != nil {
+			clusterInfo["windows_licensing"] = *cluster.MsftLicenseConfig.WindowsLicensing
+		}
+	}
+	return clusterInfo
+}
+
+// upgradeClusterInfoV0 converts the cluster_info map of a version 0 state to a
+// single element of clusterInfoSchema. Remaining attributes are populated on refresh.
+func upgradeClusterInfoV0(rawClusterInfo interface{}, clusterID interface{}) []interface{} {
+	clusterInfoV0, ok := rawClusterInfo.(map[string]interface{})
+	if !ok || len(clusterInfoV0) == 0 {
+		return []interface{}{}
+	}
+	clusterInfo := map[string]interface{}{
+		"cluster_id": clusterID,
+	}
+	for _, key := range []string{"cluster_name", "cluster_state", "host_instance_type", "mssql_licensing", "windows_licensing"} {
+		if value, ok := clusterInfoV0[key]; ok {
+			clusterInfo[key] = value
+		}
+	}
+	return []interface{}{clusterInfo}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func boolValue(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
+
+// expandStringSet returns the elements of a set of strings, sorted.
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	sort.Strings(values)
+	return values
+}
+
 // toHostInstanceType converts from the Schema format of the host_instance_type to
 // the possible string values defined in the VMC SDK
 func toHostInstanceType(userPassedHostInstanceType string) (string, error) {
@@ -131,3 +503,132 @@ func toHostInstanceType(userPassedHostInstanceType string) (string, error) {
 		return "", fmt.Errorf("unknown host instance type: %s", userPassedHostInstanceType)
 	}
 }
+
+// removeHostIDsSchema the remove_host_ids argument of the SDDC and cluster resources.
+func removeHostIDsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The ESX host identifiers to remove when the number of hosts decreases. If not set, VMC picks the hosts to remove.",
+	}
+}
+
+// getRemoveHostIDs returns the ESX hosts to remove from the cluster when its
+// number of hosts decreases by count, nil to let VMC pick them.
+func getRemoveHostIDs(d *schema.ResourceData, connectorWrapper *connector.Wrapper, sddcID string, clusterID string, count int) ([]string, error) {
+	var removeHostIDs []string
+	for _, hostID := range d.Get("remove_host_ids").(*schema.Set).List() {
+		removeHostIDs = append(removeHostIDs, hostID.(string))
+	}
+	if len(removeHostIDs) == 0 {
+		return nil, nil
+	}
+	sddc, err := GetSddc(connectorWrapper, connectorWrapper.OrgID, sddcID)
+	if err != nil {
+		return nil, HandleDataSourceReadError("SDDC", err)
+	}
+	if sddc.ResourceConfig != nil {
+		for _, cluster := range sddc.ResourceConfig.Clusters {
+			if cluster.ClusterId == clusterID {
+				return validateRemoveHostIDs(cluster, removeHostIDs, count)
+			}
+		}
+	}
+	return nil, fmt.Errorf("cannot find cluster %s on SDDC %s", clusterID, sddcID)
+}
+
+// validateRemoveHostIDs checks that the hosts to remove belong to the cluster, that
+// there are count of them, and that they are balanced across the availability
+// zones of a stretched cluster.
+func validateRemoveHostIDs(cluster model.Cluster, removeHostIDs []string, count int) ([]string, error) {
+	hostAvailabilityZones := map[string]string{}
+	for _, host := range cluster.EsxHostList {
+		hostAvailabilityZones[stringValue(host.EsxId)] = stringValue(host.AvailabilityZone)
+	}
+	removedPerAvailabilityZone := map[string]int{}
+	for _, hostID := range removeHostIDs {
+		availabilityZone, ok := hostAvailabilityZones[hostID]
+		if !ok {
+			return nil, fmt.Errorf("host %s of remove_host_ids is not in cluster %s", hostID, cluster.ClusterId)
+		}
+		removedPerAvailabilityZone[availabilityZone]++
+	}
+	if len(removeHostIDs) != count {
+		return nil, fmt.Errorf("remove_host_ids lists %d hosts, but the number of hosts decreases by %d", len(removeHostIDs), count)
+	}
+	if len(cluster.AvailabilityZones) > 1 {
+		for _, availabilityZone := range cluster.AvailabilityZones {
+			if removedPerAvailabilityZone[availabilityZone]*len(cluster.AvailabilityZones) != count {
+				return nil, fmt.Errorf("remove_host_ids must be balanced across the availability zones %s of the cluster, %d hosts are removed from %s",
+					strings.Join(cluster.AvailabilityZones, ", "), removedPerAvailabilityZone[availabilityZone], availabilityZone)
+			}
+		}
+	}
+	sort.Strings(removeHostIDs)
+	return removeHostIDs, nil
+}
+
+// importClusterScopedResource imports resources that apply to a cluster, such as
+// its EDRS policy, by the cluster_id,sddc_id ID.
+func importClusterScopedResource(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected cluster_id,sddc_id", d.Id())
+	}
+	if err := IsValidUUID(idParts[0]); err != nil {
+		return nil, fmt.Errorf("invalid format for cluster_id : %v", err)
+	}
+	if err := IsValidUUID(idParts[1]); err != nil {
+		return nil, fmt.Errorf("invalid format for sddc_id : %v", err)
+	}
+
+	d.SetId(idParts[0])
+	if err := d.Set("cluster_id", idParts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("sddc_id", idParts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// fromHostInstanceType the reverse of toHostInstanceType, returns the instance
+// type of the VMC API unchanged when it is not known to the provider.
+func fromHostInstanceType(hostInstanceType string) string {
+	for _, userPassedHostInstanceType := range []string{constants.HostInstancetypeI3, constants.HostInstancetypeI3EN,
+		constants.HostInstancetypeI4I, constants.HostInstancetypeC6I, constants.HostInstancetypeM7i24xl, constants.HostInstancetypeM7i48xl} {
+		if instanceType, _ := toHostInstanceType(userPassedHostInstanceType); instanceType == hostInstanceType {
+			return userPassedHostInstanceType
+		}
+	}
+	return hostInstanceType
+}
+
+// sddcCredentials the vCenter and NSX passwords of the SDDC by attribute, empty
+// when they must not be stored in the state.
+func sddcCredentials(sddc *model.Sddc, omitCredentials bool) map[string]string {
+	credentials := map[string]string{
+		"cloud_password":           "",
+		"nsxt_cloudadmin_password": "",
+		"nsxt_cloudaudit_password": "",
+	}
+	// The NSX passwords are nil when the user's access_token doesn't have NSX roles
+	if !omitCredentials && sddc.ResourceConfig != nil {
+		credentials["cloud_password"] = stringValue(sddc.ResourceConfig.CloudPassword)
+		credentials["nsxt_cloudadmin_password"] = stringValue(sddc.ResourceConfig.NsxCloudAdminPassword)
+		credentials["nsxt_cloudaudit_password"] = stringValue(sddc.ResourceConfig.NsxCloudAuditPassword)
+	}
+	return credentials
+}
+
+// setSddcCredentials sets the vCenter and NSX passwords of the SDDC, or clears
+// them when they must not be stored in the state.
+func setSddcCredentials(d *schema.ResourceData, sddc *model.Sddc, omitCredentials bool) error {
+	for key, value := range sddcCredentials(sddc, omitCredentials) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/vmware/vsphere-automation-sdk-go/services/vmc/model"
 
@@ -38,6 +39,16 @@ func TestToHostInstanceType(t *testing.T) {
 	}
 }
 
+func TestFromHostInstanceType(t *testing.T) {
+	for _, hostInstanceType := range []string{constants.HostInstancetypeI3, constants.HostInstancetypeI3EN,
+		constants.HostInstancetypeI4I, constants.HostInstancetypeC6I, constants.HostInstancetypeM7i24xl, constants.HostInstancetypeM7i48xl} {
+		instanceType, err := toHostInstanceType(hostInstanceType)
+		assert.NoError(t, err)
+		assert.Equal(t, hostInstanceType, fromHostInstanceType(instanceType))
+	}
+	assert.Equal(t, model.SddcConfig_HOST_INSTANCE_TYPE_R5_METAL, fromHostInstanceType(model.SddcConfig_HOST_INSTANCE_TYPE_R5_METAL))
+}
+
 func TestGetHostCountOnCluster(t *testing.T) {
 	type inputStruct struct {
 		sddc      *model.Sddc
@@ -99,3 +110,185 @@ func TestGetHostCountOnCluster(t *testing.T) {
 		assert.Equal(t, got, testCase.want)
 	}
 }
+
+func TestFlattenClusterInfo(t *testing.T) {
+	clusterName := "Cluster-1"
+	clusterState := "READY"
+	instanceType := model.SddcConfig_HOST_INSTANCE_TYPE_I4I_METAL
+	cpuCores := int64(32)
+	storage := int64(20004)
+	enabled := constants.CapitalLicenseConfigEnabled
+	esxID := "esx-1"
+	hostname := "esx-1.sddc.vmwarevmc.com"
+	availabilityZone := "us-west-2a"
+	esxState := "READY"
+	cluster := model.Cluster{
+		ClusterId:         "cluster-1",
+		ClusterName:       &clusterName,
+		ClusterState:      &clusterState,
+		EsxHostInfo:       &model.EsxHostInfo{InstanceType: &instanceType},
+		HostCpuCoresCount: &cpuCores,
+		AvailabilityZones: []string{availabilityZone},
+		ClusterCapacity:   &model.EntityCapacity{StorageCapacityGib: &storage},
+		MsftLicenseConfig: &model.MsftLicensingConfig{MssqlLicensing: &enabled},
+		EsxHostList: []model.AwsEsxHost{
+			{EsxId: &esxID, Hostname: &hostname, AvailabilityZone: &availabilityZone, EsxState: &esxState, InstanceType: &instanceType},
+		},
+	}
+
+	got := flattenClusterInfo(cluster)
+	assert.Equal(t, "cluster-1", got["cluster_id"])
+	assert.Equal(t, clusterName, got["cluster_name"])
+	assert.Equal(t, instanceType, got["host_instance_type"])
+	assert.Equal(t, 32, got["host_cpu_cores_count"])
+	assert.Equal(t, 20004, got["storage_capacity_gib"])
+	assert.Equal(t, 0, got["memory_capacity_gib"])
+	assert.Equal(t, enabled, got["mssql_licensing"])
+	assert.Equal(t, "", got["windows_licensing"])
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"esx_id":            esxID,
+			"name":              "",
+			"hostname":          hostname,
+			"availability_zone": availabilityZone,
+			"esx_state":         esxState,
+			"instance_type":     instanceType,
+		},
+	}, got["hosts"])
+
+	// the result must be accepted by the schema it is written to
+	testResourceSchema := schema.TestResourceDataRaw(t, clusterSchema(), map[string]interface{}{})
+	assert.NoError(t, testResourceSchema.Set("cluster", []map[string]interface{}{got}))
+	assert.Equal(t, esxID, testResourceSchema.Get("cluster.0.hosts.0.esx_id"))
+}
+
+func TestSetSddcCredentials(t *testing.T) {
+	cloudPassword := "cloud-password"
+	adminPassword := "admin-password"
+	sddc := model.Sddc{
+		ResourceConfig: &model.AwsSddcResourceConfig{
+			CloudPassword:         &cloudPassword,
+			NsxCloudAdminPassword: &adminPassword,
+		},
+	}
+
+	testResourceSchema := schema.TestResourceDataRaw(t, sddcSchema(), map[string]interface{}{})
+	assert.NoError(t, setSddcCredentials(testResourceSchema, &sddc, false))
+	assert.Equal(t, cloudPassword, testResourceSchema.Get("cloud_password"))
+	assert.Equal(t, adminPassword, testResourceSchema.Get("nsxt_cloudadmin_password"))
+	// the audit password is nil when the access token doesn't have NSX roles
+	assert.Equal(t, "", testResourceSchema.Get("nsxt_cloudaudit_password"))
+
+	assert.NoError(t, setSddcCredentials(testResourceSchema, &sddc, true))
+	assert.Equal(t, "", testResourceSchema.Get("cloud_password"))
+	assert.Equal(t, "", testResourceSchema.Get("nsxt_cloudadmin_password"))
+}
+
+func TestValidateStorageCapacity(t *testing.T) {
+	tests := []struct {
+		hostInstanceType string
+		storageCapacity  string
+		err              string
+	}{
+		{hostInstanceType: constants.HostInstancetypeI3EN, storageCapacity: "35TB"},
+		{hostInstanceType: constants.HostInstancetypeI4I, storageCapacity: ""},
+		{hostInstanceType: constants.HostInstancetypeI4I, storageCapacity: "15TB",
+			err: "storage_capacity is not supported by host instance type I4I_METAL, only by: I3EN_METAL"},
+		{hostInstanceType: "", storageCapacity: "15TB",
+			err: "storage_capacity is not supported by host instance type I3_METAL, only by: I3EN_METAL"},
+		{hostInstanceType: constants.HostInstancetypeI3EN, storageCapacity: "40TB",
+			err: "storage_capacity 40TB is not supported by host instance type I3EN_METAL, supported values: 15TB, 20TB, 25TB, 30TB, 35TB"},
+	}
+
+	for _, testCase := range tests {
+		err := validateStorageCapacity(testCase.hostInstanceType, testCase.storageCapacity)
+		if testCase.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.err)
+		}
+	}
+}
+
+func TestStorageCapacities(t *testing.T) {
+	assert.Equal(t, []string{"15TB", "20TB", "25TB", "30TB", "35TB"}, storageCapacities())
+}
+
+func TestHostStorageCapacity(t *testing.T) {
+	tests := []struct {
+		clusterStorageCapacityGib int64
+		numHosts                  int
+		storageCapacity           string
+	}{
+		{clusterStorageCapacityGib: 15003, numHosts: 1, storageCapacity: "15TB"},
+		{clusterStorageCapacityGib: 3 * 15003, numHosts: 3, storageCapacity: "15TB"},
+		{clusterStorageCapacityGib: 4 * 35007, numHosts: 4, storageCapacity: "35TB"},
+		{clusterStorageCapacityGib: 60000, numHosts: 3, storageCapacity: "20TB"},
+		{clusterStorageCapacityGib: 3 * 15003, numHosts: 1, storageCapacity: ""},
+		{clusterStorageCapacityGib: 138240, numHosts: 3, storageCapacity: ""},
+		{clusterStorageCapacityGib: 45009, numHosts: 0, storageCapacity: ""},
+	}
+	for _, testCase := range tests {
+		assert.Equal(t, testCase.storageCapacity, hostStorageCapacity(testCase.clusterStorageCapacityGib, testCase.numHosts),
+			"%d GiB on %d hosts", testCase.clusterStorageCapacityGib, testCase.numHosts)
+	}
+}
+
+func TestValidateRemoveHostIDs(t *testing.T) {
+	newHost := func(esxID string, availabilityZone string) model.AwsEsxHost {
+		return model.AwsEsxHost{EsxId: &esxID, AvailabilityZone: &availabilityZone}
+	}
+	singleAZCluster := model.Cluster{
+		ClusterId:         "cluster-1",
+		AvailabilityZones: []string{"us-west-2a"},
+		EsxHostList:       []model.AwsEsxHost{newHost("esx-1", "us-west-2a"), newHost("esx-2", "us-west-2a"), newHost("esx-3", "us-west-2a")},
+	}
+	multiAZCluster := model.Cluster{
+		ClusterId:         "cluster-2",
+		AvailabilityZones: []string{"us-west-2a", "us-west-2b"},
+		EsxHostList: []model.AwsEsxHost{newHost("esx-1", "us-west-2a"), newHost("esx-2", "us-west-2a"), newHost("esx-3", "us-west-2a"),
+			newHost("esx-4", "us-west-2b"), newHost("esx-5", "us-west-2b"), newHost("esx-6", "us-west-2b")},
+	}
+
+	tests := []struct {
+		cluster       model.Cluster
+		removeHostIDs []string
+		count         int
+		want          []string
+		err           string
+	}{
+		{cluster: singleAZCluster, removeHostIDs: []string{"esx-3", "esx-1"}, count: 2, want: []string{"esx-1", "esx-3"}},
+		{cluster: singleAZCluster, removeHostIDs: []string{"esx-7"}, count: 1,
+			err: "host esx-7 of remove_host_ids is not in cluster cluster-1"},
+		{cluster: singleAZCluster, removeHostIDs: []string{"esx-1"}, count: 2,
+			err: "remove_host_ids lists 1 hosts, but the number of hosts decreases by 2"},
+		{cluster: multiAZCluster, removeHostIDs: []string{"esx-4", "esx-2"}, count: 2, want: []string{"esx-2", "esx-4"}},
+		{cluster: multiAZCluster, removeHostIDs: []string{"esx-1", "esx-2"}, count: 2,
+			err: "remove_host_ids must be balanced across the availability zones us-west-2a, us-west-2b of the cluster, 2 hosts are removed from us-west-2a"},
+	}
+
+	for _, testCase := range tests {
+		got, err := validateRemoveHostIDs(testCase.cluster, testCase.removeHostIDs, testCase.count)
+		if testCase.err == "" {
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		} else {
+			assert.EqualError(t, err, testCase.err)
+		}
+	}
+}
+
+func TestGetSddcNsxtReverseProxyURL(t *testing.T) {
+	sddcID := "c1a2d1d5-5b7b-4a8b-9c8e-8f0e0c9d3a11"
+	nsxtReverseProxyURL := "https://nsx-1-2-3-4.rp.vmwarevmc.com/vmc/reverse-proxy/api/orgs/org/sddcs/" + sddcID + "/sks-nsxt-manager"
+	nsxtReverseProxyURLCache.Store(sddcID, nsxtReverseProxyURL)
+	defer nsxtReverseProxyURLCache.Delete(sddcID)
+
+	// The cached URL is returned without querying the SDDC
+	got, err := getSddcNsxtReverseProxyURL(sddcID, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, nsxtReverseProxyURL, got)
+
+	_, err = getSddcNsxtReverseProxyURL("another-sddc", nil)
+	assert.EqualError(t, err, "nil connector.Wrapper provided")
+}